@@ -11,8 +11,12 @@ package arg
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
+
+	"pkg.re/essentialkaos/ek.v7/strutil"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -22,12 +26,18 @@ import (
 	INT argument type is integer
 	BOOL argument type is boolean
 	FLOAT argument type is floating number
+	DURATION argument type is duration (e.g. 30s, 5m, 2h)
+	LIST argument type is list of strings (argument can be defined more than one time)
+	COUNT argument type is counter (every occurrence of argument increments value)
 */
 const (
-	STRING = 0
-	INT    = 1
-	BOOL   = 2
-	FLOAT  = 3
+	STRING   = 0
+	INT      = 1
+	BOOL     = 2
+	FLOAT    = 3
+	DURATION = 4
+	LIST     = 5
+	COUNT    = 6
 )
 
 // Error codes
@@ -42,6 +52,7 @@ const (
 	ERROR_WRONG_FORMAT        = 7
 	ERROR_CONFLICT            = 8
 	ERROR_BOUND_NOT_SET       = 9
+	ERROR_INVALID_CHOICE      = 10
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -49,13 +60,20 @@ const (
 // V basic argument struct
 type V struct {
 	Type      int     // argument type
-	Max       float64 // maximum integer argument value
-	Min       float64 // minimum integer argument value
+	Max       float64 // maximum integer argument value (in seconds for durations)
+	Min       float64 // minimum integer argument value (in seconds for durations)
 	Alias     string  // list of aliases
 	Conflicts string  // list of conflicts arguments
 	Bound     string  // list of bound arguments
 	Mergeble  bool    // argument supports arguments value merging
 	Required  bool    // argument is required
+	EnvVar    string  // name of environment variable with argument value
+
+	Allowed []string // list of allowed values
+
+	Desc        string // argument description for usage info
+	Placeholder string // value placeholder for usage info
+	Group       string // group name for usage info
 
 	set bool // Non exported field
 
@@ -69,7 +87,9 @@ type Map map[string]*V
 type Arguments struct {
 	full        Map
 	short       map[string]string
+	names       []argumentName
 	initialized bool
+	helpNeeded  bool
 
 	hasRequired  bool
 	hasBound     bool
@@ -81,6 +101,9 @@ type ArgumentError struct {
 	Arg      string
 	BoundArg string
 	Type     int
+	Value    string   // invalid value
+	Allowed  []string // list of allowed values
+	Similar  []string // list of similar supported arguments
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -95,6 +118,11 @@ type argumentName struct {
 // global is global arguments
 var global *Arguments
 
+// AutoHelp enable automatic handling of "--help" and "-h" arguments (if such
+// arguments are not registered). If one of them is passed, Parse doesn't
+// return errors and HelpRequested returns true.
+var AutoHelp = false
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // Add add new supported argument
@@ -107,13 +135,13 @@ func (args *Arguments) Add(name string, arg *V) error {
 
 	switch {
 	case arg == nil:
-		return ArgumentError{"--" + a.Long, "", ERROR_ARG_IS_NIL}
+		return ArgumentError{Arg: "--" + a.Long, Type: ERROR_ARG_IS_NIL}
 	case a.Long == "":
-		return ArgumentError{"", "", ERROR_NO_NAME}
+		return ArgumentError{Type: ERROR_NO_NAME}
 	case args.full[a.Long] != nil:
-		return ArgumentError{"--" + a.Long, "", ERROR_DUPLICATE_LONGNAME}
+		return ArgumentError{Arg: "--" + a.Long, Type: ERROR_DUPLICATE_LONGNAME}
 	case a.Short != "" && args.short[a.Short] != "":
-		return ArgumentError{"-" + a.Short, "", ERROR_DUPLICATE_SHORTNAME}
+		return ArgumentError{Arg: "-" + a.Short, Type: ERROR_DUPLICATE_SHORTNAME}
 	}
 
 	if arg.Required {
@@ -129,6 +157,7 @@ func (args *Arguments) Add(name string, arg *V) error {
 	}
 
 	args.full[a.Long] = arg
+	args.names = append(args.names, a)
 
 	if a.Short != "" {
 		args.short[a.Short] = a.Long
@@ -152,9 +181,17 @@ func (args *Arguments) Add(name string, arg *V) error {
 // AddMap add supported arguments as map
 func (args *Arguments) AddMap(argsMap Map) []error {
 	var errs []error
+	var names []string
+
+	for name := range argsMap {
+		names = append(names, name)
+	}
 
-	for name, arg := range argsMap {
-		err := args.Add(name, arg)
+	// Arguments are added in the same order for every run
+	sort.Strings(names)
+
+	for _, name := range names {
+		err := args.Add(name, argsMap[name])
 
 		if err != nil {
 			errs = append(errs, err)
@@ -174,12 +211,16 @@ func (args *Arguments) GetS(name string) string {
 		return ""
 	case args.full[a.Long].Value == nil:
 		return ""
-	case arg.Type == INT:
+	case arg.Type == INT, arg.Type == COUNT:
 		return strconv.Itoa(arg.Value.(int))
 	case arg.Type == FLOAT:
 		return strconv.FormatFloat(arg.Value.(float64), 'f', -1, 64)
 	case arg.Type == BOOL:
 		return strconv.FormatBool(arg.Value.(bool))
+	case arg.Type == DURATION:
+		return arg.Value.(time.Duration).String()
+	case arg.Type == LIST:
+		return strings.Join(arg.Value.([]string), " ")
 	default:
 		return arg.Value.(string)
 	}
@@ -213,6 +254,12 @@ func (args *Arguments) GetI(name string) int {
 		}
 		return 0
 
+	case arg.Type == DURATION:
+		return int(arg.Value.(time.Duration) / time.Second)
+
+	case arg.Type == LIST:
+		return 0
+
 	default:
 		return arg.Value.(int)
 	}
@@ -242,12 +289,21 @@ func (args *Arguments) GetB(name string) bool {
 		}
 		return false
 
-	case arg.Type == INT:
+	case arg.Type == INT, arg.Type == COUNT:
 		if arg.Value.(int) > 0 {
 			return true
 		}
 		return false
 
+	case arg.Type == DURATION:
+		if arg.Value.(time.Duration) > 0 {
+			return true
+		}
+		return false
+
+	case arg.Type == LIST:
+		return len(arg.Value.([]string)) != 0
+
 	default:
 		return arg.Value.(bool)
 	}
@@ -272,7 +328,7 @@ func (args *Arguments) GetF(name string) float64 {
 		}
 		return 0.0
 
-	case arg.Type == INT:
+	case arg.Type == INT, arg.Type == COUNT:
 		return float64(arg.Value.(int))
 
 	case arg.Type == BOOL:
@@ -281,11 +337,71 @@ func (args *Arguments) GetF(name string) float64 {
 		}
 		return 0.0
 
+	case arg.Type == DURATION:
+		return arg.Value.(time.Duration).Seconds()
+
+	case arg.Type == LIST:
+		return 0.0
+
 	default:
 		return arg.Value.(float64)
 	}
 }
 
+// GetD get argument value as duration
+func (args *Arguments) GetD(name string) time.Duration {
+	a := parseName(name)
+	arg, ok := args.full[a.Long]
+
+	switch {
+	case !ok:
+		return 0
+
+	case args.full[a.Long].Value == nil:
+		return 0
+
+	case arg.Type == STRING:
+		result, err := time.ParseDuration(arg.Value.(string))
+		if err == nil {
+			return result
+		}
+		return 0
+
+	case arg.Type == INT, arg.Type == COUNT:
+		return time.Duration(arg.Value.(int)) * time.Second
+
+	case arg.Type == FLOAT:
+		return time.Duration(arg.Value.(float64) * float64(time.Second))
+
+	case arg.Type == BOOL, arg.Type == LIST:
+		return 0
+
+	default:
+		return arg.Value.(time.Duration)
+	}
+}
+
+// GetL get argument value as slice with strings (for LIST arguments it
+// contains all values of argument)
+func (args *Arguments) GetL(name string) []string {
+	a := parseName(name)
+	arg, ok := args.full[a.Long]
+
+	switch {
+	case !ok:
+		return nil
+
+	case args.full[a.Long].Value == nil:
+		return nil
+
+	case arg.Type == LIST:
+		return append([]string(nil), arg.Value.([]string)...)
+
+	default:
+		return []string{args.GetS(name)}
+	}
+}
+
 // Has check that argument exists and set
 func (args *Arguments) Has(name string) bool {
 	a := parseName(name)
@@ -316,7 +432,20 @@ func (args *Arguments) Parse(rawArgs []string, argsMap ...Map) ([]string, []erro
 		return []string{}, errs
 	}
 
-	return args.parseArgs(rawArgs)
+	argList, errs := args.parseArgs(rawArgs)
+
+	// Arguments are not validated if user asks for help
+	if args.helpNeeded {
+		return argList, nil
+	}
+
+	return argList, errs
+}
+
+// HelpRequested return true if automatic help is enabled and "--help" or
+// "-h" was passed
+func (args *Arguments) HelpRequested() bool {
+	return args.helpNeeded
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -384,6 +513,24 @@ func GetF(name string) float64 {
 	return global.GetF(name)
 }
 
+// GetD get argument value as duration
+func GetD(name string) time.Duration {
+	if global == nil || global.initialized == false {
+		return 0
+	}
+
+	return global.GetD(name)
+}
+
+// GetL get argument value as slice with strings
+func GetL(name string) []string {
+	if global == nil || global.initialized == false {
+		return nil
+	}
+
+	return global.GetL(name)
+}
+
 // Has check that argument exists and set
 func Has(name string) bool {
 	if global == nil || global.initialized == false {
@@ -402,6 +549,16 @@ func Parse(argsMap ...Map) ([]string, []error) {
 	return global.Parse(os.Args[1:], argsMap...)
 }
 
+// HelpRequested return true if automatic help is enabled and "--help" or
+// "-h" was passed
+func HelpRequested() bool {
+	if global == nil || global.initialized == false {
+		return false
+	}
+
+	return global.HelpRequested()
+}
+
 // ParseArgName parse combined name and return long and short arguments
 func ParseArgName(arg string) (string, string) {
 	a := parseName(arg)
@@ -417,7 +574,7 @@ func Q(args ...string) string {
 
 func (args *Arguments) parseArgs(rawArgs []string) ([]string, []error) {
 	if len(rawArgs) == 0 {
-		return nil, args.validate()
+		return nil, append(args.applyEnvVars(), args.validate()...)
 	}
 
 	var (
@@ -426,8 +583,14 @@ func (args *Arguments) parseArgs(rawArgs []string) ([]string, []error) {
 		errorList []error
 	)
 
-	for _, curArg := range rawArgs {
+	for index, curArg := range rawArgs {
 		if argName == "" {
+			// All arguments after "--" are treated as positional
+			if curArg == "--" {
+				argList = append(argList, rawArgs[index+1:]...)
+				break
+			}
+
 			var (
 				curArgName  string
 				curArgValue string
@@ -441,6 +604,10 @@ func (args *Arguments) parseArgs(rawArgs []string) ([]string, []error) {
 				argList = append(argList, curArg)
 				continue
 
+			case args.isAutoHelp(curArg):
+				args.helpNeeded = true
+				continue
+
 			case curArgLen > 2 && curArg[0:2] == "--":
 				curArgName, curArgValue, err = args.parseLongArgument(curArg[2:curArgLen])
 
@@ -463,7 +630,7 @@ func (args *Arguments) parseArgs(rawArgs []string) ([]string, []error) {
 					updateArgument(args.full[curArgName], curArgName, curArgValue),
 				)
 			} else {
-				if args.full[curArgName] != nil && args.full[curArgName].Type == BOOL {
+				if args.full[curArgName] != nil && isFlag(args.full[curArgName]) {
 					errorList = appendError(
 						errorList,
 						updateArgument(args.full[curArgName], curArgName, ""),
@@ -482,10 +649,11 @@ func (args *Arguments) parseArgs(rawArgs []string) ([]string, []error) {
 		}
 	}
 
+	errorList = append(errorList, args.applyEnvVars()...)
 	errorList = append(errorList, args.validate()...)
 
 	if argName != "" {
-		errorList = append(errorList, ArgumentError{"--" + argName, "", ERROR_EMPTY_VALUE})
+		errorList = append(errorList, ArgumentError{Arg: "--" + argName, Type: ERROR_EMPTY_VALUE})
 	}
 
 	return argList, errorList
@@ -496,7 +664,11 @@ func (args *Arguments) parseLongArgument(arg string) (string, string, error) {
 		argSlice := strings.Split(arg, "=")
 
 		if len(argSlice) <= 1 || argSlice[1] == "" {
-			return "", "", ArgumentError{"--" + argSlice[0], "", ERROR_WRONG_FORMAT}
+			return "", "", ArgumentError{Arg: "--" + argSlice[0], Type: ERROR_WRONG_FORMAT}
+		}
+
+		if args.full[argSlice[0]] == nil {
+			return "", "", args.getUnsupportedError(argSlice[0])
 		}
 
 		return argSlice[0], strings.Join(argSlice[1:], "="), nil
@@ -506,7 +678,26 @@ func (args *Arguments) parseLongArgument(arg string) (string, string, error) {
 		return arg, "", nil
 	}
 
-	return "", "", ArgumentError{"--" + arg, "", ERROR_UNSUPPORTED}
+	return "", "", args.getUnsupportedError(arg)
+}
+
+// getUnsupportedError return error for unsupported long argument with
+// list of similar supported arguments
+func (args *Arguments) getUnsupportedError(arg string) error {
+	var names []string
+
+	for name := range args.full {
+		names = append(names, "--"+name)
+	}
+
+	// Similar arguments with same distance are returned in the same order
+	sort.Strings(names)
+
+	return ArgumentError{
+		Arg:     "--" + arg,
+		Type:    ERROR_UNSUPPORTED,
+		Similar: strutil.Suggest("--"+arg, names, 3),
+	}
 }
 
 func (args *Arguments) parseShortArgument(arg string) (string, string, error) {
@@ -514,25 +705,67 @@ func (args *Arguments) parseShortArgument(arg string) (string, string, error) {
 		argSlice := strings.Split(arg, "=")
 
 		if len(argSlice) <= 1 || argSlice[1] == "" {
-			return "", "", ArgumentError{"-" + argSlice[0], "", ERROR_WRONG_FORMAT}
+			return "", "", ArgumentError{Arg: "-" + argSlice[0], Type: ERROR_WRONG_FORMAT}
 		}
 
 		argName := argSlice[0]
 
 		if args.short[argName] == "" {
-			return "", "", ArgumentError{"-" + argName, "", ERROR_UNSUPPORTED}
+			return "", "", ArgumentError{Arg: "-" + argName, Type: ERROR_UNSUPPORTED}
 		}
 
 		return args.short[argName], strings.Join(argSlice[1:], "="), nil
 	}
 
 	if args.short[arg] == "" {
-		return "", "", ArgumentError{"-" + arg, "", ERROR_UNSUPPORTED}
+		// Repeated counter argument (e.g. -vvv)
+		if isRepeated(arg) {
+			name := args.short[arg[:1]]
+
+			if name != "" && args.full[name].Type == COUNT {
+				return name, strconv.Itoa(len(arg)), nil
+			}
+		}
+
+		return "", "", ArgumentError{Arg: "-" + arg, Type: ERROR_UNSUPPORTED}
 	}
 
 	return args.short[arg], "", nil
 }
 
+// applyEnvVars set values of not set arguments from environment variables
+func (args *Arguments) applyEnvVars() []error {
+	var errorList []error
+
+	for n, v := range args.full {
+		if v.set || v.EnvVar == "" {
+			continue
+		}
+
+		value, ok := os.LookupEnv(v.EnvVar)
+
+		if !ok || value == "" {
+			continue
+		}
+
+		if v.Type == BOOL {
+			enabled, err := strconv.ParseBool(value)
+
+			if err != nil {
+				errorList = append(errorList, ArgumentError{Arg: "--" + n, Type: ERROR_WRONG_FORMAT})
+			}
+
+			if !enabled {
+				continue
+			}
+		}
+
+		errorList = appendError(errorList, updateArgument(v, n, value))
+	}
+
+	return errorList
+}
+
 func (args *Arguments) validate() []error {
 	if !args.hasRequired && !args.hasBound && !args.hasConflicts {
 		return nil
@@ -542,7 +775,7 @@ func (args *Arguments) validate() []error {
 
 	for n, v := range args.full {
 		if v.Required == true && v.Value == nil {
-			errorList = append(errorList, ArgumentError{n, "", ERROR_REQUIRED_NOT_SET})
+			errorList = append(errorList, ArgumentError{Arg: n, Type: ERROR_REQUIRED_NOT_SET})
 		}
 
 		if v.Conflicts != "" {
@@ -550,7 +783,7 @@ func (args *Arguments) validate() []error {
 
 			for _, c := range conflicts {
 				if args.Has(c.Long) {
-					errorList = append(errorList, ArgumentError{n, c.Long, ERROR_CONFLICT})
+					errorList = append(errorList, ArgumentError{Arg: n, BoundArg: c.Long, Type: ERROR_CONFLICT})
 				}
 			}
 		}
@@ -560,7 +793,7 @@ func (args *Arguments) validate() []error {
 
 			for _, b := range bound {
 				if !args.Has(b.Long) {
-					errorList = append(errorList, ArgumentError{n, b.Long, ERROR_BOUND_NOT_SET})
+					errorList = append(errorList, ArgumentError{Arg: n, BoundArg: b.Long, Type: ERROR_BOUND_NOT_SET})
 				}
 			}
 		}
@@ -598,6 +831,10 @@ func parseArgList(list string) []argumentName {
 }
 
 func updateArgument(arg *V, name string, value string) error {
+	if len(arg.Allowed) != 0 && !isFlag(arg) && !isAllowed(value, arg.Allowed) {
+		return ArgumentError{Arg: "--" + name, Type: ERROR_INVALID_CHOICE, Value: value, Allowed: arg.Allowed}
+	}
+
 	switch arg.Type {
 	case STRING:
 		return updateStringArgument(arg, value)
@@ -610,6 +847,15 @@ func updateArgument(arg *V, name string, value string) error {
 
 	case INT:
 		return updateIntArgument(name, arg, value)
+
+	case DURATION:
+		return updateDurationArgument(name, arg, value)
+
+	case LIST:
+		return updateListArgument(arg, value)
+
+	case COUNT:
+		return updateCountArgument(name, arg, value)
 	}
 
 	return fmt.Errorf("Unsuported argument type %d", arg.Type)
@@ -626,6 +872,44 @@ func updateStringArgument(arg *V, value string) error {
 	return nil
 }
 
+func updateListArgument(arg *V, value string) error {
+	if arg.set {
+		arg.Value = append(arg.Value.([]string), value)
+	} else {
+		arg.Value = []string{value}
+		arg.set = true
+	}
+
+	return nil
+}
+
+func updateCountArgument(name string, arg *V, value string) error {
+	var count = 1
+
+	if value != "" {
+		var err error
+
+		count, err = strconv.Atoi(value)
+
+		if err != nil || count < 0 {
+			return ArgumentError{Arg: "--" + name, Type: ERROR_WRONG_FORMAT}
+		}
+	}
+
+	if arg.set {
+		count += arg.Value.(int)
+	}
+
+	if arg.Max > 0 && count > int(arg.Max) {
+		count = int(arg.Max)
+	}
+
+	arg.Value = count
+	arg.set = true
+
+	return nil
+}
+
 func updateBooleanArgument(arg *V) error {
 	arg.Value = true
 	arg.set = true
@@ -637,7 +921,7 @@ func updateFloatArgument(name string, arg *V, value string) error {
 	floatValue, err := strconv.ParseFloat(value, 64)
 
 	if err != nil {
-		return ArgumentError{"--" + name, "", ERROR_WRONG_FORMAT}
+		return ArgumentError{Arg: "--" + name, Type: ERROR_WRONG_FORMAT}
 	}
 
 	var resultFloat float64
@@ -662,7 +946,7 @@ func updateIntArgument(name string, arg *V, value string) error {
 	intValue, err := strconv.Atoi(value)
 
 	if err != nil {
-		return ArgumentError{"--" + name, "", ERROR_WRONG_FORMAT}
+		return ArgumentError{Arg: "--" + name, Type: ERROR_WRONG_FORMAT}
 	}
 
 	var resultInt int
@@ -683,6 +967,48 @@ func updateIntArgument(name string, arg *V, value string) error {
 	return nil
 }
 
+func updateDurationArgument(name string, arg *V, value string) error {
+	durValue, err := time.ParseDuration(value)
+
+	if err != nil {
+		return ArgumentError{Arg: "--" + name, Type: ERROR_WRONG_FORMAT}
+	}
+
+	if arg.Min != arg.Max {
+		durValue = time.Duration(betweenFloat(durValue.Seconds(), arg.Min, arg.Max) * float64(time.Second))
+	}
+
+	if arg.set && arg.Mergeble {
+		arg.Value = arg.Value.(time.Duration) + durValue
+	} else {
+		arg.Value = durValue
+		arg.set = true
+	}
+
+	return nil
+}
+
+// isFlag return true if argument doesn't require value
+func isFlag(arg *V) bool {
+	return arg.Type == BOOL || arg.Type == COUNT
+}
+
+// isAllowed return true if value is in list of allowed values
+func isAllowed(value string, allowed []string) bool {
+	for _, v := range allowed {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRepeated return true if string contains only one repeated symbol
+func isRepeated(s string) bool {
+	return len(s) > 1 && strings.Count(s, s[:1]) == len(s)
+}
+
 func appendError(errList []error, err error) []error {
 	if err == nil {
 		return errList
@@ -716,6 +1042,13 @@ func betweenFloat(val, min, max float64) float64 {
 func (e ArgumentError) Error() string {
 	switch e.Type {
 	default:
+		if len(e.Similar) != 0 {
+			return fmt.Sprintf(
+				"Argument %s is not supported (did you mean %s?)",
+				e.Arg, strings.Join(e.Similar, ", "),
+			)
+		}
+
 		return fmt.Sprintf("Argument %s is not supported", e.Arg)
 	case ERROR_EMPTY_VALUE:
 		return fmt.Sprintf("Non-boolean argument %s is empty", e.Arg)
@@ -733,6 +1066,11 @@ func (e ArgumentError) Error() string {
 		return fmt.Sprintf("Argument %s conflicts with argument %s", e.Arg, e.BoundArg)
 	case ERROR_BOUND_NOT_SET:
 		return fmt.Sprintf("Argument %s must be defined with argument %s", e.BoundArg, e.Arg)
+	case ERROR_INVALID_CHOICE:
+		return fmt.Sprintf(
+			"Argument %s has invalid value \"%s\" (allowed values: %s)",
+			e.Arg, e.Value, strings.Join(e.Allowed, ", "),
+		)
 	}
 }
 
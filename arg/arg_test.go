@@ -8,8 +8,10 @@ package arg
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	. "pkg.re/check.v1"
 )
@@ -307,10 +309,43 @@ func (s *ArgUtilSuite) TestParsing(c *C) {
 
 	// //////////////////////////////////////////////////////////////////////////////// //
 
-	fArgs, errs := NewArguments().Parse([]string{"-", "--"}, Map{"t:test": {}})
+	_, errs = NewArguments().Parse([]string{"--strng"}, Map{"s:string": {}, "S:string2": {}, "t:test": {}})
+
+	c.Assert(errs, Not(HasLen), 0)
+	c.Assert(errs[0].(ArgumentError).Similar, DeepEquals, []string{"--string", "--string2"})
+	c.Assert(errs[0].Error(), Equals, "Argument --strng is not supported (did you mean --string, --string2?)")
+
+	// //////////////////////////////////////////////////////////////////////////////// //
+
+	_, errs = NewArguments().Parse([]string{"--tset=1"}, Map{"s:string": {}, "t:test": {}})
+
+	c.Assert(errs, Not(HasLen), 0)
+	c.Assert(errs[0].Error(), Equals, "Argument --tset is not supported (did you mean --test?)")
+
+	// //////////////////////////////////////////////////////////////////////////////// //
+
+	fArgs, errs := NewArguments().Parse([]string{"-", "---"}, Map{"t:test": {}})
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(fArgs, DeepEquals, []string{"-", "---"})
+
+	// //////////////////////////////////////////////////////////////////////////////// //
+
+	args := NewArguments()
+	fArgs, errs = args.Parse([]string{"file", "-t", "--", "--", "-t", "--test=1", "-x"}, Map{"t:test": {Type: BOOL}})
 
 	c.Assert(errs, HasLen, 0)
-	c.Assert(fArgs, DeepEquals, []string{"-", "--"})
+	c.Assert(fArgs, DeepEquals, []string{"file", "--", "-t", "--test=1", "-x"})
+	c.Assert(args.GetB("test"), Equals, true)
+
+	// //////////////////////////////////////////////////////////////////////////////// //
+
+	args = NewArguments()
+	fArgs, errs = args.Parse([]string{"-t", "--", "--"}, Map{"t:test": {}})
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(fArgs, HasLen, 0)
+	c.Assert(args.GetS("test"), Equals, "--")
 
 	// //////////////////////////////////////////////////////////////////////////////// //
 
@@ -376,6 +411,306 @@ func (s *ArgUtilSuite) TestParsing(c *C) {
 	c.Assert(errs[0].Error(), Equals, "Some argument does not have a name")
 }
 
+func (s *ArgUtilSuite) TestDuration(c *C) {
+	argline := "-t 1m30s --min 1s --max 2h -m 10s -m 5s -s 1h -f 1.5 -i 3"
+
+	argsMap := Map{
+		"t:timeout": {Type: DURATION},
+		"min":       {Type: DURATION, Min: 5, Max: 60},
+		"max":       {Type: DURATION, Min: 5, Max: 60},
+		"d:default": {Type: DURATION, Value: 5 * time.Second},
+		"m:merg":    {Type: DURATION, Mergeble: true},
+		"s:string":  {},
+		"f:float":   {Type: FLOAT},
+		"i:int":     {Type: INT},
+		"D:not-set": {Type: DURATION},
+		"b:bool":    {Type: BOOL},
+	}
+
+	args := NewArguments()
+	_, errs := args.Parse(strings.Split(argline, " "), argsMap)
+
+	c.Assert(errs, HasLen, 0)
+
+	c.Assert(args.GetD("timeout"), Equals, 90*time.Second)
+	c.Assert(args.GetS("timeout"), Equals, "1m30s")
+	c.Assert(args.GetI("timeout"), Equals, 90)
+	c.Assert(args.GetF("timeout"), Equals, 90.0)
+	c.Assert(args.GetB("timeout"), Equals, true)
+	c.Assert(args.GetD("min"), Equals, 5*time.Second)
+	c.Assert(args.GetD("max"), Equals, time.Minute)
+	c.Assert(args.GetD("default"), Equals, 5*time.Second)
+	c.Assert(args.Has("default"), Equals, false)
+	c.Assert(args.GetD("merg"), Equals, 15*time.Second)
+	c.Assert(args.GetD("string"), Equals, time.Hour)
+	c.Assert(args.GetD("float"), Equals, 1500*time.Millisecond)
+	c.Assert(args.GetD("int"), Equals, 3*time.Second)
+	c.Assert(args.GetD("bool"), Equals, time.Duration(0))
+	c.Assert(args.GetD("not-set"), Equals, time.Duration(0))
+	c.Assert(args.GetD("_not_exist_"), Equals, time.Duration(0))
+
+	_, errs = NewArguments().Parse([]string{"-t", "10"}, Map{"t:timeout": {Type: DURATION}})
+
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].Error(), Equals, "Argument --timeout has wrong format")
+
+	global = NewArguments()
+	global.Parse([]string{"-t", "2m"}, Map{"t:timeout": {Type: DURATION}})
+
+	c.Assert(GetD("timeout"), Equals, 2*time.Minute)
+
+	global = nil
+
+	c.Assert(GetD("timeout"), Equals, time.Duration(0))
+}
+
+func (s *ArgUtilSuite) TestList(c *C) {
+	argline := []string{"-i", "a", "--include=b", "-i", "c d", "-e", "1", "-e", "2", "-s", "abc"}
+
+	argsMap := Map{
+		"i:include": {Type: LIST},
+		"e:exclude": {Type: LIST, Value: []string{"default"}},
+		"d:default": {Type: LIST, Value: []string{"x", "y"}},
+		"s:string":  {},
+		"n:not-set": {Type: LIST},
+	}
+
+	args := NewArguments()
+	_, errs := args.Parse(argline, argsMap)
+
+	c.Assert(errs, HasLen, 0)
+
+	c.Assert(args.GetL("include"), DeepEquals, []string{"a", "b", "c d"})
+	c.Assert(args.GetL("exclude"), DeepEquals, []string{"1", "2"})
+	c.Assert(args.GetL("default"), DeepEquals, []string{"x", "y"})
+	c.Assert(args.GetL("string"), DeepEquals, []string{"abc"})
+	c.Assert(args.GetL("not-set"), IsNil)
+	c.Assert(args.GetL("_not_exist_"), IsNil)
+	c.Assert(args.Has("default"), Equals, false)
+
+	c.Assert(args.GetS("exclude"), Equals, "1 2")
+	c.Assert(args.GetB("exclude"), Equals, true)
+	c.Assert(args.GetI("exclude"), Equals, 0)
+	c.Assert(args.GetF("exclude"), Equals, 0.0)
+	c.Assert(args.GetD("exclude"), Equals, time.Duration(0))
+
+	_, errs = args.Parse([]string{"-n", "a b", "-n", "c"})
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(args.GetL("not-set"), DeepEquals, []string{"a b", "c"})
+
+	// Returned slice is a copy
+	args.GetL("not-set")[0] = "x"
+
+	c.Assert(args.GetL("not-set"), DeepEquals, []string{"a b", "c"})
+
+	global = NewArguments()
+	global.Parse([]string{"-i", "a", "-i", "b"}, Map{"i:include": {Type: LIST}})
+
+	c.Assert(GetL("include"), DeepEquals, []string{"a", "b"})
+
+	global = nil
+
+	c.Assert(GetL("include"), IsNil)
+}
+
+func (s *ArgUtilSuite) TestEnvVars(c *C) {
+	os.Setenv("EK_ARG_TEST_STRING", "ABC")
+	os.Setenv("EK_ARG_TEST_INT", "15")
+	os.Setenv("EK_ARG_TEST_FLOAT", "2.5")
+	os.Setenv("EK_ARG_TEST_BOOL", "true")
+	os.Setenv("EK_ARG_TEST_BOOL_FALSE", "0")
+
+	defer func() {
+		for _, v := range []string{"STRING", "INT", "FLOAT", "BOOL", "BOOL_FALSE"} {
+			os.Unsetenv("EK_ARG_TEST_" + v)
+		}
+	}()
+
+	argsMap := Map{
+		"s:string":  {EnvVar: "EK_ARG_TEST_STRING"},
+		"i:int":     {Type: INT, Max: 10, EnvVar: "EK_ARG_TEST_INT", Required: true},
+		"f:float":   {Type: FLOAT, Value: 1.0, EnvVar: "EK_ARG_TEST_FLOAT"},
+		"b:bool":    {Type: BOOL, EnvVar: "EK_ARG_TEST_BOOL"},
+		"B:bool2":   {Type: BOOL, EnvVar: "EK_ARG_TEST_BOOL_FALSE"},
+		"e:empty":   {Value: "default", EnvVar: "EK_ARG_TEST_UNKNOWN"},
+		"o:overlap": {EnvVar: "EK_ARG_TEST_STRING"},
+	}
+
+	args := NewArguments()
+	_, errs := args.Parse([]string{"--overlap", "DEF"}, argsMap)
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(args.Has("string"), Equals, true)
+	c.Assert(args.GetS("string"), Equals, "ABC")
+	c.Assert(args.GetI("int"), Equals, 10)
+	c.Assert(args.GetF("float"), Equals, 2.5)
+	c.Assert(args.GetB("bool"), Equals, true)
+	c.Assert(args.Has("bool2"), Equals, false)
+	c.Assert(args.Has("empty"), Equals, false)
+	c.Assert(args.GetS("empty"), Equals, "default")
+	c.Assert(args.GetS("overlap"), Equals, "DEF")
+
+	os.Setenv("EK_ARG_TEST_INT", "abc")
+
+	_, errs = NewArguments().Parse([]string{}, Map{"i:int": {Type: INT, EnvVar: "EK_ARG_TEST_INT"}})
+
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].Error(), Equals, "Argument --int has wrong format")
+}
+
+func (s *ArgUtilSuite) TestCount(c *C) {
+	argsMap := Map{
+		"v:verbose": {Type: COUNT},
+		"q:quiet":   {Type: COUNT, Max: 2},
+		"d:debug":   {Type: COUNT, Value: 0},
+		"l:level":   {Type: COUNT},
+		"s:string":  {},
+	}
+
+	args := NewArguments()
+	fArgs, errs := args.Parse([]string{"-vvv", "file", "-v", "--verbose", "-q", "-qq", "--level=5", "-s", "abc"}, argsMap)
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(fArgs, DeepEquals, []string{"file"})
+	c.Assert(args.GetI("verbose"), Equals, 5)
+	c.Assert(args.GetS("verbose"), Equals, "5")
+	c.Assert(args.GetB("verbose"), Equals, true)
+	c.Assert(args.GetF("verbose"), Equals, 5.0)
+	c.Assert(args.GetI("quiet"), Equals, 2)
+	c.Assert(args.GetI("debug"), Equals, 0)
+	c.Assert(args.GetB("debug"), Equals, false)
+	c.Assert(args.Has("debug"), Equals, false)
+	c.Assert(args.GetI("level"), Equals, 5)
+
+	_, errs = NewArguments().Parse([]string{"-ss"}, argsMap)
+
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].Error(), Equals, "Argument -ss is not supported")
+
+	_, errs = NewArguments().Parse([]string{"-l=abc"}, Map{"l:level": {Type: COUNT}})
+
+	c.Assert(errs, HasLen, 1)
+	c.Assert(errs[0].Error(), Equals, "Argument --level has wrong format")
+}
+
+func (s *ArgUtilSuite) TestAllowed(c *C) {
+	argsMap := Map{
+		"m:mode":    {Allowed: []string{"fast", "slow"}},
+		"l:level":   {Type: INT, Allowed: []string{"1", "2", "3"}},
+		"t:tag":     {Type: LIST, Allowed: []string{"a", "b"}},
+		"d:default": {Value: "other", Allowed: []string{"fast", "slow"}},
+	}
+
+	args := NewArguments()
+	_, errs := args.Parse([]string{"-m", "slow", "-l", "2", "-t", "a", "-t", "b"}, argsMap)
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(args.GetS("mode"), Equals, "slow")
+	c.Assert(args.GetI("level"), Equals, 2)
+	c.Assert(args.GetL("tag"), DeepEquals, []string{"a", "b"})
+	c.Assert(args.GetS("default"), Equals, "other")
+
+	_, errs = NewArguments().Parse([]string{"--mode=medium", "-l", "5", "-t", "c"}, argsMap)
+
+	c.Assert(errs, HasLen, 3)
+	c.Assert(errs[0].(ArgumentError).Type, Equals, ERROR_INVALID_CHOICE)
+	c.Assert(errs[0].(ArgumentError).Value, Equals, "medium")
+	c.Assert(errs[0].Error(), Equals, "Argument --mode has invalid value \"medium\" (allowed values: fast, slow)")
+	c.Assert(errs[1].Error(), Equals, "Argument --level has invalid value \"5\" (allowed values: 1, 2, 3)")
+	c.Assert(errs[2].Error(), Equals, "Argument --tag has invalid value \"c\" (allowed values: a, b)")
+
+	args = NewArguments()
+	args.AddMap(Map{"m:mode": {Allowed: []string{"fast", "slow"}, Desc: "Mode"}})
+
+	c.Assert(args.Usage("myapp"), Equals, "Usage: myapp {options}\n\nOptions\n\n  --mode, -m fast|slow  Mode\n\n")
+}
+
+func (s *ArgUtilSuite) TestUsage(c *C) {
+	AutoHelp = true
+
+	defer func() { AutoHelp = false }()
+
+	args := NewArguments()
+
+	args.AddMap(Map{
+		"s:string":  {Desc: "String value", Required: true},
+		"i:int":     {Type: INT, Desc: "Integer value"},
+		"t:timeout": {Type: DURATION, Desc: "Timeout", Group: "Network"},
+		"H:host":    {Placeholder: "host", Desc: "Host", Group: "Network"},
+		"v:verbose": {Type: BOOL, Desc: "Verbose output"},
+		"d:debug":   {Type: BOOL},
+	})
+
+	c.Assert(args.Usage("myapp"), Equals, `Usage: myapp {options}
+
+Options
+
+  --debug, -d
+  --int, -i num           Integer value
+  --string, -s value      String value (required)
+  --verbose, -v           Verbose output
+  --help, -h              Show this help message
+
+Network
+
+  --host, -H host         Host
+  --timeout, -t duration  Timeout
+
+`)
+
+	args = NewArguments()
+	args.AddMap(Map{"h:help": {Type: BOOL, Desc: "Show usage", Group: "Info"}})
+
+	c.Assert(args.Usage("myapp"), Equals, "Usage: myapp {options}\n\nInfo\n\n  --help, -h  Show usage\n\n")
+
+	args = NewArguments()
+	args.AddMap(Map{"H:help": {Type: BOOL, Desc: "Show usage"}})
+
+	c.Assert(args.Usage("myapp"), Equals, "Usage: myapp {options}\n\nOptions\n\n  --help, -H  Show usage\n  -h          Show this help message\n\n")
+
+	global = nil
+
+	c.Assert(Usage("myapp"), Equals, "Usage: myapp {options}\n\nOptions\n\n  --help, -h  Show this help message\n\n")
+
+	AutoHelp = false
+	global = nil
+
+	c.Assert(Usage("myapp"), Equals, "Usage: myapp\n\n")
+}
+
+func (s *ArgUtilSuite) TestAutoHelp(c *C) {
+	argsMap := Map{"t:test": {Desc: "Test", Required: true}}
+
+	args := NewArguments()
+	_, errs := args.Parse([]string{"--help"}, argsMap)
+
+	c.Assert(errs, HasLen, 2)
+	c.Assert(errs[0].(ArgumentError).Type, Equals, ERROR_UNSUPPORTED)
+	c.Assert(args.HelpRequested(), Equals, false)
+
+	AutoHelp = true
+
+	defer func() { AutoHelp = false }()
+
+	args = NewArguments()
+	_, errs = args.Parse([]string{"--help"}, argsMap)
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(args.HelpRequested(), Equals, true)
+
+	args = NewArguments()
+	_, errs = args.Parse([]string{"-h", "example.com"}, Map{"h:host": {}})
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(args.HelpRequested(), Equals, false)
+	c.Assert(args.GetS("host"), Equals, "example.com")
+
+	global = nil
+
+	c.Assert(HelpRequested(), Equals, false)
+}
+
 func (s *ArgUtilSuite) TestMerging(c *C) {
 	c.Assert(Q(), Equals, "")
 	c.Assert(Q("test"), Equals, "test")
@@ -24,16 +24,33 @@ func Example_parsing() {
 		"I:int2":     {Type: INT, Min: 1, Max: 10},           // Integer with limits
 		"f:float":    {Type: FLOAT, Value: 10.0},             // Float
 		"b:boolean":  {Type: BOOL},                           // Boolean
+		"t:timeout":  {Type: DURATION, Min: 1, Max: 300},     // Duration (e.g. 30s, 5m) with limits in seconds
+		"i:include":  {Type: LIST},                           // List arguments can be defined more than one time
+		"v:verbose":  {Type: COUNT},                          // Counter (-vvv or -v -v -v gives 3)
+		"M:mode":     {Allowed: []string{"fast", "slow"}},    // Argument value must be one of allowed values
 		"r:required": {Type: INT, Required: true},            // Some arguments can be marked as required
 		"m:merg":     {Type: STRING, Mergeble: true},         // Mergeble arguments can be defined more than one time
 		"h:help":     {Type: BOOL, Alias: "u:usage about"},   // You can define argument aliases
 		"e:example":  {Conflicts: "s:string S:string2"},      // Argument conflicts with string and string2 (arguments can't be set at same time)
 		"E:example2": {Bound: "int I:int2"},                  // Argument bound with int and int2 (arguments must be set at same time)
+		"p:port":     {Type: INT, EnvVar: "APP_PORT"},        // Value can be read from environment variable if argument is not set
 	}
 
+	// Usage info is built from registered arguments
+	argMap["o:output"] = &V{Desc: "Path to output file", Placeholder: "file", Group: "Output"}
+
+	// If automatic help is enabled, "--help" and "-h" are detected (if such
+	// arguments are not registered)
+	AutoHelp = true
+
 	// args contains unparsed values
 	args, errs := Parse(argMap)
 
+	if HelpRequested() {
+		fmt.Print(Usage("myapp"))
+		os.Exit(0)
+	}
+
 	if len(errs) != 0 {
 		for _, err := range errs {
 			fmt.Printf("Error: %v\n", err)
@@ -51,4 +68,32 @@ func Example_parsing() {
 	fmt.Printf("int → %d\n", GetI("int"))
 	fmt.Printf("float → %f\n", GetF("f:float"))
 	fmt.Printf("boolean → %t\n", GetB("b:boolean"))
+	fmt.Printf("timeout → %v\n", GetD("t:timeout"))
+	fmt.Printf("include → %v\n", GetL("i:include"))
+}
+
+func ExampleUsage() {
+	// Show "--help" and "-h" in usage info
+	AutoHelp = true
+
+	AddMap(Map{
+		"o:output":  {Desc: "Path to output file", Placeholder: "file", Required: true},
+		"t:timeout": {Type: DURATION, Desc: "Request timeout", Group: "Network"},
+		"v:verbose": {Type: BOOL, Desc: "Verbose output"},
+	})
+
+	fmt.Print(Usage("myapp"))
+
+	// Output:
+	// Usage: myapp {options}
+	//
+	// Options
+	//
+	//   --output, -o file       Path to output file (required)
+	//   --verbose, -v           Verbose output
+	//   --help, -h              Show this help message
+	//
+	// Network
+	//
+	//   --timeout, -t duration  Request timeout
 }
@@ -0,0 +1,264 @@
+package arg
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// _DEFAULT_GROUP is name of group for arguments without group
+const _DEFAULT_GROUP = "Options"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// usageOption contains info about option for usage info
+type usageOption struct {
+	name string
+	desc string
+}
+
+// usageGroup contains options from one group
+type usageGroup struct {
+	name    string
+	options []usageOption
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Usage return usage info for application with given name (executable name
+// is used if name is empty) built from registered arguments
+func (args *Arguments) Usage(name string) string {
+	if name == "" {
+		name = filepath.Base(os.Args[0])
+	}
+
+	var buf bytes.Buffer
+
+	groups := args.getUsageGroups()
+
+	buf.WriteString("Usage: " + name)
+
+	if len(groups) != 0 {
+		buf.WriteString(" {options}")
+	}
+
+	buf.WriteString("\n")
+
+	maxSize := getMaxUsageOptionSize(groups)
+
+	for _, group := range groups {
+		buf.WriteString("\n" + group.name + "\n\n")
+
+		for _, opt := range group.options {
+			if opt.desc == "" {
+				buf.WriteString("  " + opt.name + "\n")
+				continue
+			}
+
+			buf.WriteString("  " + opt.name)
+			buf.WriteString(strings.Repeat(" ", maxSize-len(opt.name)+2))
+			buf.WriteString(opt.desc + "\n")
+		}
+	}
+
+	buf.WriteString("\n")
+
+	return buf.String()
+}
+
+// Usage return usage info for application with given name (executable name
+// is used if name is empty) built from registered arguments
+func Usage(name string) string {
+	if global == nil || global.initialized == false {
+		global = NewArguments()
+	}
+
+	return global.Usage(name)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// isAutoHelp return true if automatic help is enabled, given argument
+// is --help or -h and such argument is not registered
+func (args *Arguments) isAutoHelp(arg string) bool {
+	if !AutoHelp {
+		return false
+	}
+
+	switch arg {
+	case "--help":
+		return args.full["help"] == nil
+	case "-h":
+		return args.short["h"] == ""
+	}
+
+	return false
+}
+
+// getUsageGroups return groups with options, options without group are
+// always in first group and other groups are sorted by name
+func (args *Arguments) getUsageGroups() []usageGroup {
+	var groups []usageGroup
+
+	index := make(map[string]int)
+
+	for _, name := range args.getSortedNames() {
+		v := args.full[name.Long]
+		group := v.Group
+
+		if group == "" {
+			group = _DEFAULT_GROUP
+		}
+
+		if _, ok := index[group]; !ok {
+			index[group] = len(groups)
+			groups = append(groups, usageGroup{name: group})
+		}
+
+		groups[index[group]].options = append(
+			groups[index[group]].options,
+			usageOption{formatUsageName(name, v), formatUsageDesc(v)},
+		)
+	}
+
+	helpOption := args.getHelpOption()
+
+	if helpOption.name == "" {
+		return groups
+	}
+
+	if len(groups) == 0 || groups[0].name != _DEFAULT_GROUP {
+		groups = append([]usageGroup{{name: _DEFAULT_GROUP}}, groups...)
+	}
+
+	groups[0].options = append(groups[0].options, helpOption)
+
+	return groups
+}
+
+// getSortedNames return names of arguments sorted by default group first
+// and then by group and long name
+func (args *Arguments) getSortedNames() []argumentName {
+	names := append([]argumentName(nil), args.names...)
+
+	sort.Sort(usageNames{names, args.full})
+
+	return names
+}
+
+// getHelpOption return option for automatic help argument
+func (args *Arguments) getHelpOption() usageOption {
+	if !AutoHelp {
+		return usageOption{}
+	}
+
+	var names []string
+
+	if args.full["help"] == nil {
+		names = append(names, "--help")
+	}
+
+	if args.short["h"] == "" {
+		names = append(names, "-h")
+	}
+
+	if len(names) == 0 {
+		return usageOption{}
+	}
+
+	return usageOption{strings.Join(names, ", "), "Show this help message"}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// usageNames is sort.Interface implementation for arguments names
+type usageNames struct {
+	names []argumentName
+	full  Map
+}
+
+func (s usageNames) Len() int      { return len(s.names) }
+func (s usageNames) Swap(i, j int) { s.names[i], s.names[j] = s.names[j], s.names[i] }
+func (s usageNames) Less(i, j int) bool {
+	g1, g2 := s.full[s.names[i].Long].Group, s.full[s.names[j].Long].Group
+
+	if g1 != g2 {
+		return g1 < g2
+	}
+
+	return s.names[i].Long < s.names[j].Long
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// formatUsageName return option name with value placeholder
+func formatUsageName(name argumentName, v *V) string {
+	result := "--" + name.Long
+
+	if name.Short != "" {
+		result += ", -" + name.Short
+	}
+
+	if isFlag(v) {
+		return result
+	}
+
+	return result + " " + getPlaceholder(v)
+}
+
+// formatUsageDesc return option description with markers
+func formatUsageDesc(v *V) string {
+	desc := v.Desc
+
+	if v.Required {
+		desc = strings.TrimSpace(desc + " (required)")
+	}
+
+	return desc
+}
+
+// getPlaceholder return value placeholder for argument
+func getPlaceholder(v *V) string {
+	if v.Placeholder != "" {
+		return v.Placeholder
+	}
+
+	if len(v.Allowed) != 0 {
+		return strings.Join(v.Allowed, "|")
+	}
+
+	switch v.Type {
+	case INT, FLOAT:
+		return "num"
+	case DURATION:
+		return "duration"
+	}
+
+	return "value"
+}
+
+// getMaxUsageOptionSize return size of longest option name
+func getMaxUsageOptionSize(groups []usageGroup) int {
+	var result int
+
+	for _, group := range groups {
+		for _, opt := range group.options {
+			if len(opt.name) > result {
+				result = len(opt.name)
+			}
+		}
+	}
+
+	return result
+}
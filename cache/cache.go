@@ -0,0 +1,301 @@
+// Package cache provides in-memory key/value cache with TTL and LRU eviction
+package cache
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Cache is concurrency-safe in-memory cache
+type Cache struct {
+	ttl     time.Duration
+	maxSize int
+
+	items map[string]*list.Element
+	lru   *list.List
+	calls map[string]*call
+
+	mu   sync.Mutex
+	stop chan bool
+}
+
+// item contains cached data
+type item struct {
+	key        string
+	data       interface{}
+	expiration time.Time
+}
+
+// call contains info about in-flight GetOrCompute call
+type call struct {
+	wg   sync.WaitGroup
+	data interface{}
+	err  error
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrComputePanic is returned to concurrent GetOrCompute callers if compute
+// function panics
+var ErrComputePanic = errors.New("Compute function panicked")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// New create new cache with given default TTL (0 means no expiration), janitor
+// interval (0 means no janitor) and max number of items (0 means no limit)
+func New(ttl, janitorInterval time.Duration, maxSize int) *Cache {
+	c := &Cache{
+		ttl:     ttl,
+		maxSize: maxSize,
+		items:   make(map[string]*list.Element),
+		lru:     list.New(),
+		calls:   make(map[string]*call),
+	}
+
+	if janitorInterval > 0 {
+		c.stop = make(chan bool)
+		go c.janitor(janitorInterval, c.stop)
+	}
+
+	return c
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Set add item to cache with default TTL
+func (c *Cache) Set(key string, data interface{}) {
+	c.SetWithTTL(key, data, c.ttl)
+}
+
+// SetWithTTL add item to cache with given TTL (0 means no expiration)
+func (c *Cache) SetWithTTL(key string, data interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	c.set(key, data, ttl)
+	c.mu.Unlock()
+}
+
+// Get return item from cache or nil if item doesn't exist or expired
+func (c *Cache) Get(key string) interface{} {
+	data, _ := c.GetOK(key)
+	return data
+}
+
+// GetOK return item from cache and true if item exists and not expired
+func (c *Cache) GetOK(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.get(key)
+}
+
+// Has return true if cache contains not expired item with given key
+func (c *Cache) Has(key string) bool {
+	_, ok := c.GetOK(key)
+	return ok
+}
+
+// GetOrCompute return item from cache or compute it using given function
+// and add to cache with default TTL. Function is called only once for all
+// concurrent calls with the same key. Result is not cached if function
+// return error.
+func (c *Cache) GetOrCompute(key string, fn func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+
+	data, ok := c.get(key)
+
+	if ok {
+		c.mu.Unlock()
+		return data, nil
+	}
+
+	if cl, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		cl.wg.Wait()
+		return cl.data, cl.err
+	}
+
+	cl := &call{}
+	cl.wg.Add(1)
+	c.calls[key] = cl
+
+	c.mu.Unlock()
+
+	c.compute(key, cl, fn)
+
+	return cl.data, cl.err
+}
+
+// Delete remove item from cache
+func (c *Cache) Delete(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+
+	if !ok {
+		return false
+	}
+
+	c.remove(elem)
+
+	return true
+}
+
+// Size return number of items in cache (including expired but not yet removed)
+func (c *Cache) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Expired remove all expired items and return number of removed items
+func (c *Cache) Expired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var count int
+	now := time.Now()
+
+	for elem := c.lru.Back(); elem != nil; {
+		prev := elem.Prev()
+
+		if elem.Value.(*item).isExpired(now) {
+			c.remove(elem)
+			count++
+		}
+
+		elem = prev
+	}
+
+	return count
+}
+
+// Flush remove all items from cache
+func (c *Cache) Flush() {
+	c.mu.Lock()
+	c.items = make(map[string]*list.Element)
+	c.lru.Init()
+	c.mu.Unlock()
+}
+
+// Close stop janitor
+func (c *Cache) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stop != nil {
+		close(c.stop)
+		c.stop = nil
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// set add item to cache (must be called under lock)
+func (c *Cache) set(key string, data interface{}, ttl time.Duration) {
+	var expiration time.Time
+
+	if ttl > 0 {
+		expiration = time.Now().Add(ttl)
+	}
+
+	if elem, ok := c.items[key]; ok {
+		it := elem.Value.(*item)
+		it.data, it.expiration = data, expiration
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.lru.PushFront(&item{key, data, expiration})
+
+	if c.maxSize > 0 {
+		for c.lru.Len() > c.maxSize {
+			c.remove(c.lru.Back())
+		}
+	}
+}
+
+// get return item from cache (must be called under lock)
+func (c *Cache) get(key string) (interface{}, bool) {
+	elem, ok := c.items[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	it := elem.Value.(*item)
+
+	if it.isExpired(time.Now()) {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+
+	return it.data, true
+}
+
+// remove remove element from cache (must be called under lock)
+func (c *Cache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.items, elem.Value.(*item).key)
+}
+
+// compute call function for in-flight call and save result to cache
+func (c *Cache) compute(key string, cl *call, fn func() (interface{}, error)) {
+	finished := false
+
+	// Call must be finished even if function panics, otherwise
+	// all waiting callers will hang forever
+	defer func() {
+		c.mu.Lock()
+
+		if !finished {
+			cl.data, cl.err = nil, ErrComputePanic
+		} else if cl.err == nil {
+			c.set(key, cl.data, c.ttl)
+		}
+
+		delete(c.calls, key)
+
+		c.mu.Unlock()
+
+		cl.wg.Done()
+	}()
+
+	cl.data, cl.err = fn()
+	finished = true
+}
+
+// janitor periodically remove expired items
+func (c *Cache) janitor(interval time.Duration, stop chan bool) {
+	ticker := time.NewTicker(interval)
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.Expired()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// isExpired return true if item is expired
+func (i *item) isExpired(now time.Time) bool {
+	return !i.expiration.IsZero() && now.After(i.expiration)
+}
@@ -0,0 +1,198 @@
+package cache
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "pkg.re/check.v1"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type CacheSuite struct{}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func Test(t *testing.T) { TestingT(t) }
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var _ = Suite(&CacheSuite{})
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func (s *CacheSuite) TestBasic(c *C) {
+	cache := New(0, 0, 0)
+
+	cache.Set("1", "test")
+	cache.Set("2", 100)
+
+	c.Assert(cache.Size(), Equals, 2)
+	c.Assert(cache.Get("1"), Equals, "test")
+	c.Assert(cache.Get("2"), Equals, 100)
+	c.Assert(cache.Get("3"), IsNil)
+	c.Assert(cache.Has("1"), Equals, true)
+	c.Assert(cache.Has("3"), Equals, false)
+
+	cache.Set("1", "abcd")
+
+	c.Assert(cache.Get("1"), Equals, "abcd")
+	c.Assert(cache.Size(), Equals, 2)
+
+	c.Assert(cache.Delete("1"), Equals, true)
+	c.Assert(cache.Delete("1"), Equals, false)
+	c.Assert(cache.Has("1"), Equals, false)
+
+	cache.Flush()
+
+	c.Assert(cache.Size(), Equals, 0)
+	c.Assert(cache.Has("2"), Equals, false)
+}
+
+func (s *CacheSuite) TestTTL(c *C) {
+	cache := New(20*time.Millisecond, 0, 0)
+
+	cache.Set("1", "test")
+	cache.SetWithTTL("2", "test", time.Hour)
+	cache.SetWithTTL("3", "test", 0)
+
+	c.Assert(cache.Has("1"), Equals, true)
+
+	time.Sleep(30 * time.Millisecond)
+
+	c.Assert(cache.Has("1"), Equals, false)
+	c.Assert(cache.Has("2"), Equals, true)
+	c.Assert(cache.Has("3"), Equals, true)
+
+	cache.Set("4", "test")
+	cache.Set("5", "test")
+
+	time.Sleep(30 * time.Millisecond)
+
+	c.Assert(cache.Size(), Equals, 4)
+	c.Assert(cache.Expired(), Equals, 2)
+	c.Assert(cache.Size(), Equals, 2)
+}
+
+func (s *CacheSuite) TestJanitor(c *C) {
+	cache := New(10*time.Millisecond, 5*time.Millisecond, 0)
+
+	cache.Set("1", "test")
+	cache.Set("2", "test")
+
+	time.Sleep(50 * time.Millisecond)
+
+	c.Assert(cache.Size(), Equals, 0)
+
+	cache.Close()
+	cache.Close()
+}
+
+func (s *CacheSuite) TestLRU(c *C) {
+	cache := New(0, 0, 3)
+
+	cache.Set("1", 1)
+	cache.Set("2", 2)
+	cache.Set("3", 3)
+
+	// Mark 1 as recently used
+	c.Assert(cache.Get("1"), Equals, 1)
+
+	cache.Set("4", 4)
+
+	c.Assert(cache.Size(), Equals, 3)
+	c.Assert(cache.Has("1"), Equals, true)
+	c.Assert(cache.Has("2"), Equals, false)
+	c.Assert(cache.Has("3"), Equals, true)
+	c.Assert(cache.Has("4"), Equals, true)
+}
+
+func (s *CacheSuite) TestGetOrCompute(c *C) {
+	cache := New(0, 0, 0)
+
+	var calls int32
+	var wg sync.WaitGroup
+
+	fn := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		return "test", nil
+	}
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			data, err := cache.GetOrCompute("1", fn)
+
+			c.Check(err, IsNil)
+			c.Check(data, Equals, "test")
+
+			wg.Done()
+		}()
+	}
+
+	wg.Wait()
+
+	c.Assert(atomic.LoadInt32(&calls), Equals, int32(1))
+	c.Assert(cache.Get("1"), Equals, "test")
+
+	data, err := cache.GetOrCompute("2", func() (interface{}, error) {
+		return nil, errors.New("Error")
+	})
+
+	c.Assert(data, IsNil)
+	c.Assert(err, NotNil)
+	c.Assert(cache.Has("2"), Equals, false)
+}
+
+func (s *CacheSuite) TestGetOrComputePanic(c *C) {
+	cache := New(0, 0, 0)
+
+	started := make(chan bool)
+	done := make(chan error)
+
+	go func() {
+		defer func() { recover() }()
+
+		cache.GetOrCompute("1", func() (interface{}, error) {
+			close(started)
+			time.Sleep(20 * time.Millisecond)
+			panic("test")
+		})
+	}()
+
+	<-started
+
+	go func() {
+		_, err := cache.GetOrCompute("1", func() (interface{}, error) {
+			return "test", nil
+		})
+
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		c.Assert(err, Equals, ErrComputePanic)
+	case <-time.After(time.Second):
+		c.Fatal("GetOrCompute hangs after panic in compute function")
+	}
+
+	data, err := cache.GetOrCompute("1", func() (interface{}, error) {
+		return "test", nil
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(data, Equals, "test")
+}
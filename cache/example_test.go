@@ -0,0 +1,51 @@
+package cache
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func ExampleNew() {
+	// Cache with 1 minute TTL, janitor which removes expired items
+	// every 5 minutes and limit for 1000 items
+	cache := New(time.Minute, 5*time.Minute, 1000)
+
+	defer cache.Close()
+
+	cache.Set("user", "bob")
+	cache.SetWithTTL("token", "abcd1234", time.Hour)
+
+	fmt.Println(cache.Get("user"))
+	fmt.Println(cache.Has("session"))
+
+	// Output:
+	// bob
+	// false
+}
+
+func ExampleCache_GetOrCompute() {
+	cache := New(time.Minute, 0, 0)
+
+	data, err := cache.GetOrCompute("answer", func() (interface{}, error) {
+		// Some expensive computation
+		return 42, nil
+	})
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Println(data)
+
+	// Output: 42
+}
@@ -10,6 +10,7 @@ package cron
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -60,9 +61,10 @@ type exprPart struct {
 }
 
 type exprInfo struct {
-	min uint8
-	max uint8
-	nt  uint8 // Naming type
+	min  uint8
+	max  uint8
+	nt   uint8  // Naming type
+	name string // Field name
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -72,55 +74,51 @@ var ErrMalformedExpression = errors.New("Expression must have 5 tokens")
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 var info = []exprInfo{
-	{0, 59, _NAMES_NONE},
-	{0, 23, _NAMES_NONE},
-	{1, 31, _NAMES_NONE},
-	{1, 12, _NAMES_MONTHS},
-	{0, 6, _NAMES_DAYS},
+	{0, 59, _NAMES_NONE, "minute"},
+	{0, 23, _NAMES_NONE, "hour"},
+	{1, 31, _NAMES_NONE, "day of month"},
+	{1, 12, _NAMES_MONTHS, "month"},
+	{0, 7, _NAMES_DAYS, "day of week"}, // 0 and 7 is Sunday
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// Parse parse cron expression
+// Parse parse and validate cron expression. Expression can contain values,
+// names of months and days (Jan, Mon), periods (1-5), intervals (*/5, 1-30/5)
+// and enums (1,5,10) of them. Also aliases (@daily, @weekly, etc...) are
+// supported. Values and bounds of periods must be in field range.
 func Parse(expr string) (*Expr, error) {
 	result := &Expr{expression: expr}
 
-	expr = strings.Replace(expr, "\t", " ", -1)
-	expr = getAliasExpression(expr)
+	expr = getAliasExpression(strings.TrimSpace(expr))
 
-	exprAr := strings.Split(expr, " ")
+	if strings.HasPrefix(expr, "@") {
+		return nil, fmt.Errorf("Unknown alias \"%s\"", expr)
+	}
+
+	exprAr := strings.Fields(expr)
 
 	if len(exprAr) != 5 {
 		return nil, ErrMalformedExpression
 	}
 
-	data := make([][]uint8, 5)
+	parts := make([]*exprPart, 5)
 
 	for tn, ei := range info {
-		token := exprAr[tn]
-
-		switch {
-		case isAnyToken(token):
-			data[tn] = fillUintSlice(ei.min, ei.max, 1)
-		case isEnumToken(token):
-			data[tn] = getEnumFromToken(token, ei.nt)
-		case isPeriodToken(token):
-			ts, te := getPeriodFromToken(token, ei.nt)
-			ts = between(ts, ei.min, ei.max)
-			te = between(te, ei.min, ei.max)
-			data[tn] = fillUintSlice(ts, te, 1)
-		case isIntervalToken(token):
-			data[tn] = fillUintSlice(ei.min, ei.max, getIntervalFromToken(token))
-		default:
-			data[tn] = []uint8{parseToken(token, ei.nt)}
+		index, err := parsePart(exprAr[tn], ei)
+
+		if err != nil {
+			return nil, err
 		}
+
+		parts[tn] = &exprPart{index, map2slice(index, ei.min, ei.max)}
 	}
 
-	result.minutes = &exprPart{slice2map(data[0]), data[0]}
-	result.hours = &exprPart{slice2map(data[1]), data[1]}
-	result.doms = &exprPart{slice2map(data[2]), data[2]}
-	result.months = &exprPart{slice2map(data[3]), data[3]}
-	result.dows = &exprPart{slice2map(data[4]), data[4]}
+	result.minutes = parts[0]
+	result.hours = parts[1]
+	result.doms = parts[2]
+	result.months = parts[3]
+	result.dows = parts[4]
 
 	return result, nil
 }
@@ -171,12 +169,18 @@ func (expr *Expr) Next(args ...time.Time) time.Time {
 	}
 
 	year := t.Year()
+	month := uint8(t.Month())
 
-	mStart := getNearPrevIndex(expr.months.tokens, uint8(t.Month()))
-	dStart := getNearPrevIndex(expr.doms.tokens, uint8(t.Day()))
+	mStart := getNearNextIndex(expr.months.tokens, month)
 
 	for y := year; y < year+5; y++ {
 		for i := mStart; i < len(expr.months.tokens); i++ {
+			dStart := 0
+
+			if y == year && expr.months.tokens[i] == month {
+				dStart = getNearNextIndex(expr.doms.tokens, uint8(t.Day()))
+			}
+
 			for j := dStart; j < len(expr.doms.tokens); j++ {
 				for k := 0; k < len(expr.hours.tokens); k++ {
 					for l := 0; l < len(expr.minutes.tokens); l++ {
@@ -205,8 +209,6 @@ func (expr *Expr) Next(args ...time.Time) time.Time {
 					}
 				}
 			}
-
-			dStart = 0
 		}
 
 		mStart = 0
@@ -226,12 +228,18 @@ func (expr *Expr) Prev(args ...time.Time) time.Time {
 	}
 
 	year := t.Year()
+	month := uint8(t.Month())
 
-	mStart := getNearNextIndex(expr.months.tokens, uint8(t.Month()))
-	dStart := getNearNextIndex(expr.doms.tokens, uint8(t.Day()))
+	mStart := getNearPrevIndex(expr.months.tokens, month)
 
 	for y := year; y >= year-5; y-- {
 		for i := mStart; i >= 0; i-- {
+			dStart := len(expr.doms.tokens) - 1
+
+			if y == year && expr.months.tokens[i] == month {
+				dStart = getNearPrevIndex(expr.doms.tokens, uint8(t.Day()))
+			}
+
 			for j := dStart; j >= 0; j-- {
 				for k := len(expr.hours.tokens) - 1; k >= 0; k-- {
 					for l := len(expr.minutes.tokens) - 1; l >= 0; l-- {
@@ -260,8 +268,6 @@ func (expr *Expr) Prev(args ...time.Time) time.Time {
 					}
 				}
 			}
-
-			dStart = len(expr.doms.tokens) - 1
 		}
 
 		mStart = len(expr.months.tokens) - 1
@@ -277,48 +283,107 @@ func (expr *Expr) String() string {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-func isAnyToken(t string) bool {
-	return t == _SYMBOL_ANY
-}
+// parsePart parse expression part and return index with all matched values
+func parsePart(token string, ei exprInfo) (map[uint8]bool, error) {
+	index := make(map[uint8]bool)
 
-func isEnumToken(t string) bool {
-	return strings.Contains(t, _SYMBOL_ENUM)
-}
+	for _, t := range strings.Split(token, _SYMBOL_ENUM) {
+		start, end, interval, err := parseRange(t, ei)
 
-func isPeriodToken(t string) bool {
-	return strings.Contains(t, _SYMBOL_PERIOD)
-}
+		if err != nil {
+			return nil, err
+		}
 
-func isIntervalToken(t string) bool {
-	return strings.Contains(t, _SYMBOL_INTERVAL)
+		for i := int(start); i <= int(end); i += interval {
+			index[uint8(i)] = true
+		}
+	}
+
+	// 7 is an alias for Sunday
+	if ei.nt == _NAMES_DAYS && index[7] {
+		delete(index, 7)
+		index[0] = true
+	}
+
+	return index, nil
 }
 
-func getEnumFromToken(t string, nt uint8) []uint8 {
-	var result []uint8
+// parseRange parse single value, period or interval
+func parseRange(t string, ei exprInfo) (uint8, uint8, int, error) {
+	var err error
+
+	interval := 1
+	hasInterval := strings.Contains(t, _SYMBOL_INTERVAL)
 
-	for _, tt := range strings.Split(t, _SYMBOL_ENUM) {
-		switch {
-		case isPeriodToken(tt):
-			ts, te := getPeriodFromToken(tt, nt)
-			result = append(result, fillUintSlice(ts, te, 1)...)
-		default:
-			result = append(result, parseToken(tt, nt))
+	if hasInterval {
+		ts := strings.Split(t, _SYMBOL_INTERVAL)
+		interval, err = strconv.Atoi(ts[1])
+
+		if len(ts) != 2 || err != nil || interval <= 0 {
+			return 0, 0, 0, fmt.Errorf("Invalid interval \"%s\" in %s field", t, ei.name)
 		}
+
+		t = ts[0]
 	}
 
-	return result
-}
+	switch {
+	case t == _SYMBOL_ANY:
+		return ei.min, ei.max, interval, nil
 
-func getPeriodFromToken(t string, nt uint8) (uint8, uint8) {
-	ts := strings.Split(t, _SYMBOL_PERIOD)
+	case strings.Contains(t, _SYMBOL_PERIOD):
+		ts := strings.Split(t, _SYMBOL_PERIOD)
 
-	return parseToken(ts[0], nt), parseToken(ts[1], nt)
-}
+		if len(ts) != 2 {
+			return 0, 0, 0, fmt.Errorf("Invalid period \"%s\" in %s field", t, ei.name)
+		}
+
+		start, err := parseToken(ts[0], ei)
+
+		if err != nil {
+			return 0, 0, 0, err
+		}
 
-func getIntervalFromToken(t string) uint8 {
-	ts := strings.Split(t, _SYMBOL_INTERVAL)
+		end, err := parseToken(ts[1], ei)
 
-	return str2uint(ts[1])
+		if err != nil {
+			return 0, 0, 0, err
+		}
+
+		if start > end {
+			return 0, 0, 0, fmt.Errorf("Invalid period \"%s\" in %s field", t, ei.name)
+		}
+
+		for _, value := range []int{start, end} {
+			if value < int(ei.min) || value > int(ei.max) {
+				return 0, 0, 0, fmt.Errorf(
+					"Value %d is out of range (%d-%d) in %s field",
+					value, ei.min, ei.max, ei.name,
+				)
+			}
+		}
+
+		return uint8(start), uint8(end), interval, nil
+	}
+
+	value, err := parseToken(t, ei)
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if value < int(ei.min) || value > int(ei.max) {
+		return 0, 0, 0, fmt.Errorf(
+			"Value %d is out of range (%d-%d) in %s field",
+			value, ei.min, ei.max, ei.name,
+		)
+	}
+
+	// Value with interval (e.g. 5/15) means period from value to max value
+	if hasInterval {
+		return uint8(value), ei.max, interval, nil
+	}
+
+	return uint8(value), uint8(value), interval, nil
 }
 
 func getAliasExpression(expr string) string {
@@ -340,22 +405,29 @@ func getAliasExpression(expr string) string {
 	return expr
 }
 
-func parseToken(t string, nt uint8) uint8 {
-	switch nt {
+// parseToken parse number or name
+func parseToken(t string, ei exprInfo) (int, error) {
+	switch ei.nt {
 	case _NAMES_DAYS:
 		tu, ok := getDayNumByName(t)
 		if ok {
-			return tu
+			return int(tu), nil
 		}
 
 	case _NAMES_MONTHS:
 		tu, ok := getMonthNumByName(t)
 		if ok {
-			return tu
+			return int(tu), nil
 		}
 	}
 
-	return str2uint(t)
+	value, err := strconv.Atoi(t)
+
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("Invalid value \"%s\" in %s field", t, ei.name)
+	}
+
+	return value, nil
 }
 
 func getDayNumByName(token string) (uint8, bool) {
@@ -410,31 +482,21 @@ func getMonthNumByName(token string) (uint8, bool) {
 	return 0, false
 }
 
-func fillUintSlice(start, end, interval uint8) []uint8 {
+// map2slice return sorted slice with values from index
+func map2slice(index map[uint8]bool, min, max uint8) []uint8 {
 	var result []uint8
 
-	for i := start; i <= end; i += interval {
-		result = append(result, i)
-	}
-
-	return result
-}
-
-func slice2map(s []uint8) map[uint8]bool {
-	result := make(map[uint8]bool)
-
-	for _, u := range s {
-		result[u] = true
+	for i := int(min); i <= int(max); i++ {
+		if index[uint8(i)] {
+			result = append(result, uint8(i))
+		}
 	}
 
 	return result
 }
 
-func str2uint(t string) uint8 {
-	u, _ := strconv.ParseUint(t, 10, 8)
-	return uint8(u)
-}
-
+// getNearNextIndex return index of first item greater than or equal to given
+// item or length of slice if there is no such item
 func getNearNextIndex(items []uint8, item uint8) int {
 	for i := 0; i < len(items); i++ {
 		if items[i] >= item {
@@ -442,9 +504,11 @@ func getNearNextIndex(items []uint8, item uint8) int {
 		}
 	}
 
-	return 0
+	return len(items)
 }
 
+// getNearPrevIndex return index of last item less than or equal to given
+// item or -1 if there is no such item
 func getNearPrevIndex(items []uint8, item uint8) int {
 	for i := len(items) - 1; i >= 0; i-- {
 		if items[i] <= item {
@@ -452,16 +516,5 @@ func getNearPrevIndex(items []uint8, item uint8) int {
 		}
 	}
 
-	return len(items) - 1
-}
-
-func between(val, min, max uint8) uint8 {
-	switch {
-	case val < min:
-		return min
-	case val > max:
-		return max
-	default:
-		return val
-	}
+	return -1
 }
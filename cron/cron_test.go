@@ -129,8 +129,8 @@ func (s *CronSuite) TestParsing(c *C) {
 
 	e11, err := Parse("45 17 7 0-99999 1")
 
-	c.Assert(err, IsNil)
-	c.Assert(e11, NotNil)
+	c.Assert(err, NotNil)
+	c.Assert(e11, IsNil)
 }
 
 func (s *CronSuite) TestAliases(c *C) {
@@ -214,8 +214,125 @@ func (s *CronSuite) TestNearIndex(c *C) {
 
 	c.Assert(getNearNextIndex(items, 5), Equals, 4)
 	c.Assert(getNearNextIndex(items, 6), Equals, 4)
-	c.Assert(getNearNextIndex(items, 10), Equals, 0)
+	c.Assert(getNearNextIndex(items, 10), Equals, 8)
 	c.Assert(getNearPrevIndex(items, 5), Equals, 3)
 	c.Assert(getNearPrevIndex(items, 6), Equals, 4)
-	c.Assert(getNearPrevIndex(items, 0), Equals, 7)
+	c.Assert(getNearPrevIndex(items, 0), Equals, -1)
+}
+
+func (s *CronSuite) TestNearMatches(c *C) {
+	e1, err := Parse("0 12 10,20 * *")
+
+	c.Assert(err, IsNil)
+
+	c.Assert(e1.Next(time.Date(2017, 1, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 1, 10, 12, 0, 0, 0, time.UTC))
+	c.Assert(e1.Next(time.Date(2017, 1, 15, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 1, 20, 12, 0, 0, 0, time.UTC))
+	c.Assert(e1.Next(time.Date(2017, 1, 25, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 2, 10, 12, 0, 0, 0, time.UTC))
+	c.Assert(e1.Next(time.Date(2017, 12, 25, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2018, 1, 10, 12, 0, 0, 0, time.UTC))
+	c.Assert(e1.Prev(time.Date(2017, 1, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2016, 12, 20, 12, 0, 0, 0, time.UTC))
+	c.Assert(e1.Prev(time.Date(2017, 1, 15, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 1, 10, 12, 0, 0, 0, time.UTC))
+	c.Assert(e1.Prev(time.Date(2017, 1, 25, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 1, 20, 12, 0, 0, 0, time.UTC))
+	c.Assert(e1.Prev(time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 2, 20, 12, 0, 0, 0, time.UTC))
+
+	e2, err := Parse("0 12 1 6,9 *")
+
+	c.Assert(err, IsNil)
+
+	c.Assert(e2.Next(time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC))
+	c.Assert(e2.Next(time.Date(2017, 7, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 9, 1, 12, 0, 0, 0, time.UTC))
+	c.Assert(e2.Next(time.Date(2017, 10, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC))
+	c.Assert(e2.Prev(time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2016, 9, 1, 12, 0, 0, 0, time.UTC))
+	c.Assert(e2.Prev(time.Date(2017, 7, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC))
+	c.Assert(e2.Prev(time.Date(2017, 10, 5, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 9, 1, 12, 0, 0, 0, time.UTC))
+	c.Assert(e2.Next(time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 9, 1, 12, 0, 0, 0, time.UTC))
+	c.Assert(e2.Prev(time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)), Equals, time.Date(2016, 9, 1, 12, 0, 0, 0, time.UTC))
+}
+
+func (s *CronSuite) TestValidation(c *C) {
+	var err error
+
+	_, err = Parse("@every")
+	c.Assert(err, ErrorMatches, `Unknown alias "@every"`)
+
+	_, err = Parse("* * *")
+	c.Assert(err, Equals, ErrMalformedExpression)
+
+	_, err = Parse("60 * * * *")
+	c.Assert(err, ErrorMatches, `Value 60 is out of range \(0-59\) in minute field`)
+
+	_, err = Parse("* * 0 * *")
+	c.Assert(err, ErrorMatches, `Value 0 is out of range \(1-31\) in day of month field`)
+
+	_, err = Parse("1-99 * * * *")
+	c.Assert(err, ErrorMatches, `Value 99 is out of range \(0-59\) in minute field`)
+
+	_, err = Parse("* * 0-10 * *")
+	c.Assert(err, ErrorMatches, `Value 0 is out of range \(1-31\) in day of month field`)
+
+	_, err = Parse("* abc * * *")
+	c.Assert(err, ErrorMatches, `Invalid value "abc" in hour field`)
+
+	_, err = Parse("* * * Foo *")
+	c.Assert(err, ErrorMatches, `Invalid value "Foo" in month field`)
+
+	_, err = Parse("*/0 * * * *")
+	c.Assert(err, ErrorMatches, `Invalid interval "\*/0" in minute field`)
+
+	_, err = Parse("*/a * * * *")
+	c.Assert(err, ErrorMatches, `Invalid interval "\*/a" in minute field`)
+
+	_, err = Parse("* * * * 5-1")
+	c.Assert(err, ErrorMatches, `Invalid period "5-1" in day of week field`)
+
+	_, err = Parse("* * * * 1-2-3")
+	c.Assert(err, ErrorMatches, `Invalid period "1-2-3" in day of week field`)
+
+	_, err = Parse("1,,2 * * * *")
+	c.Assert(err, ErrorMatches, `Invalid value "" in minute field`)
+
+	_, err = Parse("  0  12\t* * *  ")
+	c.Assert(err, IsNil)
+}
+
+func (s *CronSuite) TestExtendedSyntax(c *C) {
+	e1, err := Parse("1-30/10 5/6 * * *")
+
+	c.Assert(err, IsNil)
+	c.Assert(e1.minutes.tokens, DeepEquals, []uint8{1, 11, 21})
+	c.Assert(e1.hours.tokens, DeepEquals, []uint8{5, 11, 17, 23})
+
+	e2, err := Parse("30,15,0,15 * * * 7")
+
+	c.Assert(err, IsNil)
+	c.Assert(e2.minutes.tokens, DeepEquals, []uint8{0, 15, 30})
+	c.Assert(e2.dows.tokens, DeepEquals, []uint8{0})
+	c.Assert(e2.IsDue(time.Date(2017, 3, 12, 10, 15, 0, 0, time.UTC)), Equals, true)
+
+	e3, err := Parse("0 0 * * Fri-7")
+
+	c.Assert(err, IsNil)
+	c.Assert(e3.dows.tokens, DeepEquals, []uint8{0, 5, 6})
+
+	e4, err := Parse("@daily")
+
+	c.Assert(err, IsNil)
+	c.Assert(
+		e4.Next(time.Date(2017, 3, 8, 14, 5, 0, 0, time.UTC)),
+		Equals,
+		time.Date(2017, 3, 9, 0, 0, 0, 0, time.UTC),
+	)
+
+	e5, err := Parse("0 12 29 Feb *")
+
+	c.Assert(err, IsNil)
+	c.Assert(
+		e5.Next(time.Date(2017, 3, 8, 14, 5, 0, 0, time.UTC)),
+		Equals,
+		time.Date(2020, 2, 29, 12, 0, 0, 0, time.UTC),
+	)
+	c.Assert(
+		e5.Prev(time.Date(2017, 3, 8, 14, 5, 0, 0, time.UTC)),
+		Equals,
+		time.Date(2016, 2, 29, 12, 0, 0, 0, time.UTC),
+	)
 }
@@ -10,7 +10,11 @@ package csv
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
 	"io"
+	"strconv"
 	"strings"
 )
 
@@ -18,10 +22,23 @@ import (
 
 // Reader is reader struct
 type Reader struct {
-	Comma rune
-	br    *bufio.Reader
+	Comma         rune // Fields separator
+	FieldsNum     int  // Expected number of fields (0 - any number of fields)
+	SkipMalformed bool // Skip lines with unexpected number of fields instead of returning error
+
+	br   *bufio.Reader
+	buf  []byte
+	line int
 }
 
+// Row is CSV row
+type Row []string
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrEmptyDest is returned by ReadTo if destination slice is empty
+var ErrEmptyDest = errors.New("Destination slice length must be greater than 0")
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // NewReader create new reader
@@ -36,11 +53,180 @@ func NewReader(r io.Reader) *Reader {
 
 // Read reads line from csv file
 func (r *Reader) Read() ([]string, error) {
-	str, _, err := r.br.ReadLine()
+	for {
+		str, err := r.readLine()
+
+		if err != nil || (len(str) == 0 && r.FieldsNum == 0) {
+			return []string{}, err
+		}
+
+		ok, err := r.checkFieldsNum(str)
+
+		if err != nil {
+			return []string{}, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		return strings.Split(string(str), string(r.Comma)), nil
+	}
+}
+
+// ReadTo reads line from csv file to given slice. If line contains more fields
+// than slice length, extra fields are ignored, if less, missing fields are set
+// to empty string. Slice can be reused for reading next lines, so reading
+// doesn't require allocation for every line.
+func (r *Reader) ReadTo(dst []string) error {
+	if len(dst) == 0 {
+		return ErrEmptyDest
+	}
+
+	for {
+		str, err := r.readLine()
+
+		if err != nil {
+			return err
+		}
+
+		ok, err := r.checkFieldsNum(str)
+
+		if err != nil {
+			return err
+		}
 
-	if err != nil || len(str) == 0 {
-		return []string{}, err
+		if !ok {
+			continue
+		}
+
+		r.splitTo(string(str), dst)
+
+		return nil
+	}
+}
+
+// Line return number of last read line
+func (r *Reader) Line() int {
+	return r.line
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Size return number of fields in row
+func (r Row) Size() int {
+	return len(r)
+}
+
+// GetS return field value as string
+func (r Row) GetS(index int) string {
+	if index < 0 || index >= len(r) {
+		return ""
 	}
 
-	return strings.Split(string(str), string(r.Comma)), nil
+	return r[index]
+}
+
+// GetI return field value as int
+func (r Row) GetI(index int) (int, error) {
+	return strconv.Atoi(r.GetS(index))
+}
+
+// GetI64 return field value as int64
+func (r Row) GetI64(index int) (int64, error) {
+	return strconv.ParseInt(r.GetS(index), 10, 64)
+}
+
+// GetF return field value as float
+func (r Row) GetF(index int) (float64, error) {
+	return strconv.ParseFloat(r.GetS(index), 64)
+}
+
+// GetB return field value as boolean
+func (r Row) GetB(index int) bool {
+	switch strings.ToLower(r.GetS(index)) {
+	case "1", "true", "yes", "y":
+		return true
+	}
+
+	return false
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// readLine read full line from reader
+func (r *Reader) readLine() ([]byte, error) {
+	line, isPrefix, err := r.br.ReadLine()
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.line++
+
+	if !isPrefix {
+		return line, nil
+	}
+
+	// Line is longer than reader buffer, so we collect all parts
+	// to internal buffer
+	r.buf = append(r.buf[:0], line...)
+
+	for isPrefix {
+		line, isPrefix, err = r.br.ReadLine()
+
+		if err != nil {
+			return nil, err
+		}
+
+		r.buf = append(r.buf, line...)
+	}
+
+	return r.buf, nil
+}
+
+// checkFieldsNum check number of fields in line and return false if
+// line must be skipped
+func (r *Reader) checkFieldsNum(line []byte) (bool, error) {
+	if r.FieldsNum <= 0 {
+		return true, nil
+	}
+
+	fieldsNum := bytes.Count(line, []byte(string(r.Comma))) + 1
+
+	if fieldsNum == r.FieldsNum {
+		return true, nil
+	}
+
+	if r.SkipMalformed {
+		return false, nil
+	}
+
+	return false, fmt.Errorf(
+		"Line %d contains %d fields (expected %d)",
+		r.line, fieldsNum, r.FieldsNum,
+	)
+}
+
+// splitTo split line to given slice
+func (r *Reader) splitTo(line string, dst []string) {
+	var done bool
+
+	comma := string(r.Comma)
+
+	for i := range dst {
+		if done {
+			dst[i] = ""
+			continue
+		}
+
+		index := strings.Index(line, comma)
+
+		if index == -1 {
+			dst[i], done = line, true
+			continue
+		}
+
+		dst[i], line = line[:index], line[index+len(comma):]
+	}
 }
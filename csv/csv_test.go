@@ -11,6 +11,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	. "pkg.re/check.v1"
@@ -85,6 +86,104 @@ func (s *CSVSuite) TestParsing(c *C) {
 	}
 }
 
+func (s *CSVSuite) TestReadTo(c *C) {
+	reader := NewReader(strings.NewReader("1;A;2.5\n2;B\n\n3;C;1.5;X\n"))
+	row := make(Row, 3)
+
+	c.Assert(reader.ReadTo(nil), Equals, ErrEmptyDest)
+
+	c.Assert(reader.ReadTo(row), IsNil)
+	c.Assert(row, DeepEquals, Row{"1", "A", "2.5"})
+	c.Assert(reader.Line(), Equals, 1)
+
+	c.Assert(reader.ReadTo(row), IsNil)
+	c.Assert(row, DeepEquals, Row{"2", "B", ""})
+
+	c.Assert(reader.ReadTo(row), IsNil)
+	c.Assert(row, DeepEquals, Row{"", "", ""})
+
+	c.Assert(reader.ReadTo(row), IsNil)
+	c.Assert(row, DeepEquals, Row{"3", "C", "1.5"})
+
+	c.Assert(reader.ReadTo(row), Equals, io.EOF)
+}
+
+func (s *CSVSuite) TestMalformed(c *C) {
+	data := "1\tA\n2\tB\tC\n\n3\tD\n"
+
+	reader := NewReader(strings.NewReader(data))
+	reader.Comma = '\t'
+	reader.FieldsNum = 2
+
+	rec, err := reader.Read()
+	c.Assert(err, IsNil)
+	c.Assert(rec, DeepEquals, []string{"1", "A"})
+
+	rec, err = reader.Read()
+	c.Assert(err, ErrorMatches, `Line 2 contains 3 fields \(expected 2\)`)
+	c.Assert(rec, HasLen, 0)
+
+	reader = NewReader(strings.NewReader(data))
+	reader.Comma = '\t'
+	reader.FieldsNum = 2
+	reader.SkipMalformed = true
+
+	row := make(Row, 2)
+
+	c.Assert(reader.ReadTo(row), IsNil)
+	c.Assert(row, DeepEquals, Row{"1", "A"})
+	c.Assert(reader.ReadTo(row), IsNil)
+	c.Assert(row, DeepEquals, Row{"3", "D"})
+	c.Assert(reader.Line(), Equals, 4)
+	c.Assert(reader.ReadTo(row), Equals, io.EOF)
+}
+
+func (s *CSVSuite) TestLongLines(c *C) {
+	field := strings.Repeat("A", 10000)
+	reader := NewReader(strings.NewReader(field + ";" + field + "\n1;2\n"))
+
+	rec, err := reader.Read()
+
+	c.Assert(err, IsNil)
+	c.Assert(rec, DeepEquals, []string{field, field})
+
+	rec, err = reader.Read()
+
+	c.Assert(err, IsNil)
+	c.Assert(rec, DeepEquals, []string{"1", "2"})
+}
+
+func (s *CSVSuite) TestRow(c *C) {
+	row := Row{"abc", "-12", "3.14", "true", "0"}
+
+	c.Assert(row.Size(), Equals, 5)
+	c.Assert(row.GetS(0), Equals, "abc")
+	c.Assert(row.GetS(-1), Equals, "")
+	c.Assert(row.GetS(10), Equals, "")
+
+	i, err := row.GetI(1)
+	c.Assert(err, IsNil)
+	c.Assert(i, Equals, -12)
+
+	_, err = row.GetI(0)
+	c.Assert(err, NotNil)
+
+	i64, err := row.GetI64(1)
+	c.Assert(err, IsNil)
+	c.Assert(i64, Equals, int64(-12))
+
+	f, err := row.GetF(2)
+	c.Assert(err, IsNil)
+	c.Assert(f, Equals, 3.14)
+
+	_, err = row.GetF(10)
+	c.Assert(err, NotNil)
+
+	c.Assert(row.GetB(3), Equals, true)
+	c.Assert(row.GetB(4), Equals, false)
+	c.Assert(row.GetB(10), Equals, false)
+}
+
 func (s *CSVSuite) BenchmarkRead(c *C) {
 	fd, _ := os.Open(s.dataFile)
 
@@ -103,3 +202,24 @@ func (s *CSVSuite) BenchmarkRead(c *C) {
 		}
 	}
 }
+
+func (s *CSVSuite) BenchmarkReadTo(c *C) {
+	fd, _ := os.Open(s.dataFile)
+
+	defer fd.Close()
+
+	row := make(Row, 8)
+
+	for i := 0; i < c.N; i++ {
+		reader := NewReader(fd)
+		reader.Comma = ','
+
+		for {
+			err := reader.ReadTo(row)
+
+			if err == io.EOF {
+				break
+			}
+		}
+	}
+}
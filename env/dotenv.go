@@ -0,0 +1,243 @@
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// LoadFile read variables from file with dotenv syntax and apply them to
+// process environment. If override is false, variables which already
+// defined in environment will not be changed. Variable references are
+// resolved to values which are actually applied to environment. Method
+// returns all variables defined in file.
+func LoadFile(path string, override bool) (Env, error) {
+	fd, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer fd.Close()
+
+	result := make(Env)
+	scanner := bufio.NewScanner(fd)
+
+	lookup := func(name string) string {
+		value, exist := os.LookupEnv(name)
+
+		if exist && !override {
+			return value
+		}
+
+		if fileValue, ok := result[name]; ok {
+			return fileValue
+		}
+
+		return value
+	}
+
+	var lineNum int
+
+	for scanner.Scan() {
+		lineNum++
+
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		name, value, err := parseDotEnvLine(line, lookup)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error at line %d: %v", lineNum, err)
+		}
+
+		result[name] = value
+	}
+
+	err = scanner.Err()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range result {
+		if _, exist := os.LookupEnv(name); exist && !override {
+			continue
+		}
+
+		os.Setenv(name, value)
+	}
+
+	return result, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseDotEnvLine parse line with variable definition
+func parseDotEnvLine(line string, lookup func(string) string) (string, string, error) {
+	if strings.HasPrefix(line, "export ") {
+		line = strings.TrimSpace(line[7:])
+	}
+
+	sepIndex := strings.Index(line, "=")
+
+	if sepIndex == -1 {
+		return "", "", fmt.Errorf("Can't find separator in \"%s\"", line)
+	}
+
+	name := strings.TrimSpace(line[:sepIndex])
+
+	if !isValidVarName(name) {
+		return "", "", fmt.Errorf("\"%s\" is not valid variable name", name)
+	}
+
+	value := strings.TrimSpace(line[sepIndex+1:])
+
+	if value == "" {
+		return name, "", nil
+	}
+
+	switch value[0] {
+	case '\'':
+		end := strings.Index(value[1:], "'")
+
+		if end == -1 {
+			return "", "", fmt.Errorf("Value of %s has unclosed quote", name)
+		}
+
+		return name, value[1 : end+1], nil
+
+	case '"':
+		unquoted, ok := unquoteValue(value[1:], lookup)
+
+		if !ok {
+			return "", "", fmt.Errorf("Value of %s has unclosed quote", name)
+		}
+
+		return name, unquoted, nil
+	}
+
+	// Remove inline comment
+	if commentIndex := strings.Index(value, " #"); commentIndex != -1 {
+		value = strings.TrimSpace(value[:commentIndex])
+	}
+
+	return name, expandValue(value, lookup), nil
+}
+
+// unquoteValue process escape sequences and variable references in
+// double-quoted value (escaped \$ is kept as is)
+func unquoteValue(value string, lookup func(string) string) (string, bool) {
+	var result []byte
+
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '"':
+			return string(result), true
+		case '$':
+			ref, size := expandReference(value[i:], lookup)
+			result = append(result, ref...)
+			i += size - 1
+		case '\\':
+			if i+1 == len(value) {
+				return "", false
+			}
+
+			i++
+
+			switch value[i] {
+			case 'n':
+				result = append(result, '\n')
+			case 't':
+				result = append(result, '\t')
+			case 'r':
+				result = append(result, '\r')
+			default:
+				result = append(result, value[i])
+			}
+		default:
+			result = append(result, value[i])
+		}
+	}
+
+	return "", false
+}
+
+// expandValue replace variable references (${VAR} or $VAR) by values
+// returned by lookup function
+func expandValue(value string, lookup func(string) string) string {
+	var result []byte
+
+	for i := 0; i < len(value); i++ {
+		if value[i] != '$' {
+			result = append(result, value[i])
+			continue
+		}
+
+		ref, size := expandReference(value[i:], lookup)
+		result = append(result, ref...)
+		i += size - 1
+	}
+
+	return string(result)
+}
+
+// expandReference return value of variable reference at the beginning of given
+// string and size of reference. If string doesn't start with valid reference
+// "$" is returned as is.
+func expandReference(value string, lookup func(string) string) (string, int) {
+	if strings.HasPrefix(value, "${") {
+		end := strings.Index(value, "}")
+
+		if end == -1 || !isValidVarName(value[2:end]) {
+			return "$", 1
+		}
+
+		return lookup(value[2:end]), end + 1
+	}
+
+	size := 1
+
+	for size < len(value) && isValidVarName(value[1:size+1]) {
+		size++
+	}
+
+	if size == 1 {
+		return "$", 1
+	}
+
+	return lookup(value[1:size]), size
+}
+
+// isValidVarName check variable name
+func isValidVarName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+			continue
+		case r >= '0' && r <= '9' && i != 0:
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
@@ -15,6 +15,9 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
+
+	"pkg.re/essentialkaos/ek.v7/fmtutil"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -29,7 +32,7 @@ func Get() Env {
 	env := make(Env)
 
 	for _, ev := range os.Environ() {
-		evs := strings.Split(ev, "=")
+		evs := strings.SplitN(ev, "=", 2)
 		k, v := evs[0], evs[1]
 
 		env[k] = v
@@ -40,15 +43,68 @@ func Get() Env {
 
 // Which find full path to some app
 func Which(name string) string {
-	paths := Get().Path()
+	result := WhichAll(name)
+
+	if len(result) == 0 {
+		return ""
+	}
+
+	return result[0]
+}
 
-	for _, path := range paths {
-		if syscall.Access(path+"/"+name, syscall.F_OK) == nil {
-			return path + "/" + name
+// WhichAll find all paths to some app in directories from PATH
+func WhichAll(name string) []string {
+	var result []string
+
+	for _, path := range Get().Path() {
+		if syscall.Access(path+"/"+name, syscall.F_OK) != nil {
+			continue
+		}
+
+		if !containsPathDir(result, path+"/"+name) {
+			result = append(result, path+"/"+name)
 		}
 	}
 
-	return ""
+	return result
+}
+
+// Set set environment variable value
+func Set(name, value string) error {
+	return os.Setenv(name, value)
+}
+
+// Unset remove environment variable
+func Unset(name string) error {
+	return os.Unsetenv(name)
+}
+
+// WithVars set given environment variables, execute function and restore
+// previous state of variables
+func WithVars(vars map[string]string, fn func()) {
+	prev := make(map[string]*string)
+
+	for name, value := range vars {
+		if cur, ok := os.LookupEnv(name); ok {
+			prev[name] = &cur
+		} else {
+			prev[name] = nil
+		}
+
+		os.Setenv(name, value)
+	}
+
+	defer func() {
+		for name, value := range prev {
+			if value == nil {
+				os.Unsetenv(name)
+			} else {
+				os.Setenv(name, *value)
+			}
+		}
+	}()
+
+	fn()
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -59,28 +115,125 @@ func (e Env) Path() []string {
 }
 
 // GetS return environment variable value as string
-func (e Env) GetS(name string) string {
-	return e[name]
+func (e Env) GetS(name string, defvals ...string) string {
+	value := e.get(name)
+
+	if value == "" && len(defvals) != 0 {
+		return defvals[0]
+	}
+
+	return value
 }
 
 // GetI return environment variable value as int
-func (e Env) GetI(name string) int {
-	value, err := strconv.Atoi(e[name])
+func (e Env) GetI(name string, defvals ...int) int {
+	value, err := strconv.Atoi(e.get(name))
 
 	if err != nil {
-		return -1
+		if len(defvals) == 0 {
+			return -1
+		}
+
+		return defvals[0]
 	}
 
 	return value
 }
 
 // GetF return environment variable value as float
-func (e Env) GetF(name string) float64 {
-	value, err := strconv.ParseFloat(e[name], 64)
+func (e Env) GetF(name string, defvals ...float64) float64 {
+	value, err := strconv.ParseFloat(e.get(name), 64)
 
 	if err != nil {
-		return -1.0
+		if len(defvals) == 0 {
+			return -1.0
+		}
+
+		return defvals[0]
 	}
 
 	return value
 }
+
+// GetB return environment variable value as boolean
+func (e Env) GetB(name string, defvals ...bool) bool {
+	value := e.get(name)
+
+	if value == "" {
+		if len(defvals) == 0 {
+			return false
+		}
+
+		return defvals[0]
+	}
+
+	switch strings.ToLower(value) {
+	case "0", "false", "no":
+		return false
+	default:
+		return true
+	}
+}
+
+// GetD return environment variable value as duration. Value can be
+// defined as duration string (e.g. 1h30m) or number of seconds.
+func (e Env) GetD(name string, defvals ...time.Duration) time.Duration {
+	value := e.get(name)
+
+	if value != "" {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+
+		if err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+
+		duration, err := time.ParseDuration(value)
+
+		if err == nil {
+			return duration
+		}
+	}
+
+	if len(defvals) == 0 {
+		return 0
+	}
+
+	return defvals[0]
+}
+
+// GetSZ return environment variable value as size in bytes. Value can be
+// defined as number of bytes or pretty size (e.g. 10mb).
+func (e Env) GetSZ(name string, defvals ...uint64) uint64 {
+	value := e.get(name)
+
+	if value != "" {
+		size := fmtutil.ParseSize(value)
+
+		if size != 0 || value == "0" {
+			return size
+		}
+	}
+
+	if len(defvals) == 0 {
+		return 0
+	}
+
+	return defvals[0]
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseEnvironData parse NUL-separated list of variables
+func parseEnvironData(data []byte) Env {
+	env := make(Env)
+
+	for _, ev := range strings.Split(string(data), "\x00") {
+		evs := strings.SplitN(ev, "=", 2)
+
+		if len(evs) == 2 && evs[0] != "" {
+			env[evs[0]] = evs[1]
+		}
+	}
+
+	return env
+}
@@ -8,7 +8,10 @@ package env
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	. "pkg.re/check.v1"
 )
@@ -43,3 +46,344 @@ func (s *ENVSuite) TestEnv(c *C) {
 	c.Assert(Which("cat"), Not(Equals), "")
 	c.Assert(Which("catABCD1234"), Equals, "")
 }
+
+func (s *ENVSuite) TestGetters(c *C) {
+	envs := Env{
+		"STR":       "test",
+		"INT":       "123",
+		"FLOAT":     "1.5",
+		"BOOL_YES":  "true",
+		"BOOL_NO":   "0",
+		"DURATION":  "1h30m",
+		"SECONDS":   "90",
+		"SIZE":      "10mb",
+		"SIZE_ZERO": "0",
+		"BAD":       "abcd",
+	}
+
+	c.Assert(envs.GetS("STR", "default"), Equals, "test")
+	c.Assert(envs.GetS("UNKNOWN", "default"), Equals, "default")
+	c.Assert(envs.GetI("INT", 1), Equals, 123)
+	c.Assert(envs.GetI("BAD", 1), Equals, 1)
+	c.Assert(envs.GetI("BAD"), Equals, -1)
+	c.Assert(envs.GetF("FLOAT", 2.5), Equals, 1.5)
+	c.Assert(envs.GetF("BAD", 2.5), Equals, 2.5)
+
+	c.Assert(envs.GetB("BOOL_YES"), Equals, true)
+	c.Assert(envs.GetB("BOOL_NO", true), Equals, false)
+	c.Assert(envs.GetB("UNKNOWN"), Equals, false)
+	c.Assert(envs.GetB("UNKNOWN", true), Equals, true)
+
+	c.Assert(envs.GetD("DURATION"), Equals, 90*time.Minute)
+	c.Assert(envs.GetD("SECONDS"), Equals, 90*time.Second)
+	c.Assert(envs.GetD("BAD", time.Minute), Equals, time.Minute)
+	c.Assert(envs.GetD("UNKNOWN"), Equals, time.Duration(0))
+
+	c.Assert(envs.GetSZ("SIZE"), Equals, uint64(10*1024*1024))
+	c.Assert(envs.GetSZ("SIZE_ZERO", 100), Equals, uint64(0))
+	c.Assert(envs.GetSZ("BAD", 100), Equals, uint64(100))
+	c.Assert(envs.GetSZ("UNKNOWN"), Equals, uint64(0))
+}
+
+func (s *ENVSuite) TestSetUnset(c *C) {
+	c.Assert(Set("EK_TEST_SET", "value=1"), IsNil)
+	c.Assert(Get().GetS("EK_TEST_SET"), Equals, "value=1")
+	c.Assert(Unset("EK_TEST_SET"), IsNil)
+	c.Assert(Get().GetS("EK_TEST_SET"), Equals, "")
+}
+
+func (s *ENVSuite) TestWithVars(c *C) {
+	Set("EK_TEST_OVERRIDE", "original")
+
+	WithVars(
+		map[string]string{"EK_TEST_OVERRIDE": "new", "EK_TEST_TEMP": "temp"},
+		func() {
+			envs := Get()
+			c.Assert(envs.GetS("EK_TEST_OVERRIDE"), Equals, "new")
+			c.Assert(envs.GetS("EK_TEST_TEMP"), Equals, "temp")
+		},
+	)
+
+	envs := Get()
+
+	c.Assert(envs.GetS("EK_TEST_OVERRIDE"), Equals, "original")
+
+	_, exist := envs["EK_TEST_TEMP"]
+
+	c.Assert(exist, Equals, false)
+
+	Unset("EK_TEST_OVERRIDE")
+}
+
+func (s *ENVSuite) TestLoadFile(c *C) {
+	file := c.MkDir() + "/.env"
+	data := `# comment
+export EK_DOTENV_NAME=test
+EK_DOTENV_SINGLE='single $EK_DOTENV_NAME'
+EK_DOTENV_DOUBLE="double \"${EK_DOTENV_NAME}\"\nline"
+EK_DOTENV_INLINE=value # comment
+EK_DOTENV_EMPTY=
+EK_DOTENV_EXIST=new
+`
+
+	c.Assert(ioutil.WriteFile(file, []byte(data), 0644), IsNil)
+
+	os.Setenv("EK_DOTENV_EXIST", "old")
+
+	vars, err := LoadFile(file, false)
+
+	c.Assert(err, IsNil)
+	c.Assert(vars, HasLen, 6)
+	c.Assert(vars["EK_DOTENV_NAME"], Equals, "test")
+	c.Assert(vars["EK_DOTENV_SINGLE"], Equals, "single $EK_DOTENV_NAME")
+	c.Assert(vars["EK_DOTENV_DOUBLE"], Equals, "double \"test\"\nline")
+	c.Assert(vars["EK_DOTENV_INLINE"], Equals, "value")
+	c.Assert(vars["EK_DOTENV_EMPTY"], Equals, "")
+	c.Assert(vars["EK_DOTENV_EXIST"], Equals, "new")
+
+	c.Assert(os.Getenv("EK_DOTENV_NAME"), Equals, "test")
+	c.Assert(os.Getenv("EK_DOTENV_EXIST"), Equals, "old")
+
+	_, err = LoadFile(file, true)
+
+	c.Assert(err, IsNil)
+	c.Assert(os.Getenv("EK_DOTENV_EXIST"), Equals, "new")
+
+	for name := range vars {
+		os.Unsetenv(name)
+	}
+}
+
+func (s *ENVSuite) TestLoadFileReferences(c *C) {
+	file := c.MkDir() + "/.env"
+	data := `EK_DOTENV_REF_BASE=file
+EK_DOTENV_REF_ESCAPED="costs \$5 \${EK_DOTENV_REF_BASE}"
+EK_DOTENV_REF_DOUBLE="$EK_DOTENV_REF_BASE-${EK_DOTENV_REF_BASE}"
+EK_DOTENV_REF_PLAIN=$EK_DOTENV_REF_BASE/$5
+`
+
+	c.Assert(ioutil.WriteFile(file, []byte(data), 0644), IsNil)
+
+	os.Setenv("EK_DOTENV_REF_BASE", "process")
+
+	vars, err := LoadFile(file, false)
+
+	c.Assert(err, IsNil)
+	c.Assert(vars["EK_DOTENV_REF_ESCAPED"], Equals, "costs $5 ${EK_DOTENV_REF_BASE}")
+	c.Assert(vars["EK_DOTENV_REF_DOUBLE"], Equals, "process-process")
+	c.Assert(vars["EK_DOTENV_REF_PLAIN"], Equals, "process/$5")
+	c.Assert(os.Getenv("EK_DOTENV_REF_BASE"), Equals, "process")
+
+	vars, err = LoadFile(file, true)
+
+	c.Assert(err, IsNil)
+	c.Assert(vars["EK_DOTENV_REF_DOUBLE"], Equals, "file-file")
+	c.Assert(os.Getenv("EK_DOTENV_REF_PLAIN"), Equals, "file/$5")
+
+	for name := range vars {
+		os.Unsetenv(name)
+	}
+}
+
+func (s *ENVSuite) TestLoadFileErrors(c *C) {
+	dir := c.MkDir()
+
+	_, err := LoadFile(dir+"/unknown", false)
+	c.Assert(err, NotNil)
+
+	ioutil.WriteFile(dir+"/1.env", []byte("EK_DOTENV_TEST\n"), 0644)
+	ioutil.WriteFile(dir+"/2.env", []byte("1EK_DOTENV_TEST=1\n"), 0644)
+	ioutil.WriteFile(dir+"/3.env", []byte("EK_DOTENV_TEST='abcd\n"), 0644)
+	ioutil.WriteFile(dir+"/4.env", []byte("EK_DOTENV_TEST=\"abcd\n"), 0644)
+
+	_, err = LoadFile(dir+"/1.env", false)
+	c.Assert(err, ErrorMatches, "Error at line 1: Can't find separator in \"EK_DOTENV_TEST\"")
+	_, err = LoadFile(dir+"/2.env", false)
+	c.Assert(err, ErrorMatches, "Error at line 1: \"1EK_DOTENV_TEST\" is not valid variable name")
+	_, err = LoadFile(dir+"/3.env", false)
+	c.Assert(err, ErrorMatches, "Error at line 1: Value of EK_DOTENV_TEST has unclosed quote")
+	_, err = LoadFile(dir+"/4.env", false)
+	c.Assert(err, ErrorMatches, "Error at line 1: Value of EK_DOTENV_TEST has unclosed quote")
+}
+
+func (s *ENVSuite) TestWhichAll(c *C) {
+	dir1, dir2 := c.MkDir(), c.MkDir()
+
+	ioutil.WriteFile(dir1+"/ek-test-app", []byte(""), 0755)
+	ioutil.WriteFile(dir2+"/ek-test-app", []byte(""), 0755)
+
+	WithVars(map[string]string{"PATH": dir1 + ":" + dir2 + ":" + dir1}, func() {
+		c.Assert(WhichAll("ek-test-app"), DeepEquals, []string{dir1 + "/ek-test-app", dir2 + "/ek-test-app"})
+		c.Assert(Which("ek-test-app"), Equals, dir1+"/ek-test-app")
+		c.Assert(WhichAll("ek-unknown-app"), HasLen, 0)
+	})
+}
+
+func (s *ENVSuite) TestPathManipulation(c *C) {
+	WithVars(map[string]string{"PATH": "/usr/bin:/bin"}, func() {
+		c.Assert(PathPrepend("/opt/bin", "/bin/"), IsNil)
+		c.Assert(os.Getenv("PATH"), Equals, "/opt/bin:/bin:/usr/bin")
+
+		c.Assert(PathAppend("/usr/bin", "/usr/local/bin", ""), IsNil)
+		c.Assert(os.Getenv("PATH"), Equals, "/opt/bin:/bin:/usr/bin:/usr/local/bin")
+
+		c.Assert(PathRemove("/bin", "/unknown"), IsNil)
+		c.Assert(os.Getenv("PATH"), Equals, "/opt/bin:/usr/bin:/usr/local/bin")
+
+		os.Setenv("PATH", "/usr/bin::/bin:/usr/bin/:/bin")
+
+		c.Assert(PathDedup(), IsNil)
+		c.Assert(os.Getenv("PATH"), Equals, "/usr/bin:/bin")
+	})
+}
+
+func (s *ENVSuite) TestDiff(c *C) {
+	a := Env{"A": "1", "B": "2", "C": "3"}
+	b := Env{"A": "1", "B": "22", "D": "4"}
+
+	c.Assert(Diff(a, b), DeepEquals, []Change{
+		{"B", CHANGE_MODIFIED, "2", "22"},
+		{"C", CHANGE_REMOVED, "3", ""},
+		{"D", CHANGE_ADDED, "", "4"},
+	})
+
+	c.Assert(Diff(a, a), HasLen, 0)
+}
+
+func (s *ENVSuite) TestSnapshot(c *C) {
+	os.Setenv("EK_TEST_SNAP_MOD", "1")
+	os.Setenv("EK_TEST_SNAP_DEL", "2")
+
+	snapshot := Snapshot()
+
+	os.Setenv("EK_TEST_SNAP_MOD", "10")
+	os.Setenv("EK_TEST_SNAP_NEW", "3")
+	os.Unsetenv("EK_TEST_SNAP_DEL")
+
+	c.Assert(Diff(snapshot, Snapshot()), DeepEquals, []Change{
+		{"EK_TEST_SNAP_DEL", CHANGE_REMOVED, "2", ""},
+		{"EK_TEST_SNAP_MOD", CHANGE_MODIFIED, "1", "10"},
+		{"EK_TEST_SNAP_NEW", CHANGE_ADDED, "", "3"},
+	})
+
+	c.Assert(Restore(snapshot), IsNil)
+	c.Assert(Diff(snapshot, Snapshot()), HasLen, 0)
+
+	os.Unsetenv("EK_TEST_SNAP_MOD")
+	os.Unsetenv("EK_TEST_SNAP_DEL")
+}
+
+func (s *ENVSuite) TestExport(c *C) {
+	envs := Env{
+		"APP_NAME":  "test",
+		"APP_QUOTE": "it's \"quoted\" $HOME",
+		"OTHER":     "1",
+		"BAD-NAME":  "2",
+	}
+
+	c.Assert(envs.ExportShell("APP_"), Equals,
+		"export APP_NAME='test'\n"+
+			"export APP_QUOTE='it'\\''s \"quoted\" $HOME'\n",
+	)
+
+	c.Assert(envs.ExportShell(""), Equals,
+		"export APP_NAME='test'\n"+
+			"export APP_QUOTE='it'\\''s \"quoted\" $HOME'\n"+
+			"export OTHER='1'\n",
+	)
+
+	c.Assert(envs.ExportShell("UNKNOWN_"), Equals, "")
+
+	data, err := Env{"B": "2", "A": "1"}.ExportJSON()
+
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"A":"1","B":"2"}`)
+
+	var empty Env
+
+	data, err = empty.ExportJSON()
+
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, "{}")
+}
+
+func (s *ENVSuite) TestWithPrefix(c *C) {
+	envs := Env{
+		"MYAPP_PORT":  "8080",
+		"MYAPP_HOST":  "localhost",
+		"myapp_debug": "true",
+		"MYAPP_":      "empty",
+		"OTHER":       "1",
+	}
+
+	c.Assert(envs.WithPrefix("MYAPP_"), DeepEquals, Env{"PORT": "8080", "HOST": "localhost"})
+	c.Assert(envs.WithPrefix("MYAPP_").GetI("PORT"), Equals, 8080)
+	c.Assert(envs.WithPrefix("UNKNOWN_"), HasLen, 0)
+
+	CaseInsensitive = true
+
+	c.Assert(envs.WithPrefix("MYAPP_"), DeepEquals, Env{"PORT": "8080", "HOST": "localhost", "debug": "true"})
+	c.Assert(envs.GetS("myapp_port"), Equals, "8080")
+	c.Assert(envs.GetB("MYAPP_DEBUG"), Equals, true)
+	c.Assert(envs.GetI("Myapp_Port"), Equals, 8080)
+	c.Assert(envs.GetS("unknown"), Equals, "")
+
+	CaseInsensitive = false
+
+	c.Assert(envs.GetS("myapp_port"), Equals, "")
+}
+
+func (s *ENVSuite) TestWatch(c *C) {
+	os.Unsetenv("EK_WATCH_TEST")
+
+	ch, stop := Watch([]string{"EK_WATCH_TEST"}, 5*time.Millisecond)
+
+	time.Sleep(20 * time.Millisecond)
+
+	os.Setenv("EK_WATCH_TEST", "1")
+	c.Assert(waitChange(ch), DeepEquals, Change{"EK_WATCH_TEST", CHANGE_ADDED, "", "1"})
+
+	os.Setenv("EK_WATCH_TEST", "2")
+	c.Assert(waitChange(ch), DeepEquals, Change{"EK_WATCH_TEST", CHANGE_MODIFIED, "1", "2"})
+
+	os.Unsetenv("EK_WATCH_TEST")
+	c.Assert(waitChange(ch), DeepEquals, Change{"EK_WATCH_TEST", CHANGE_REMOVED, "2", ""})
+
+	stop()
+	stop()
+
+	select {
+	case _, ok := <-ch:
+		c.Assert(ok, Equals, false)
+	case <-time.After(time.Second):
+		c.Fatal("Channel is not closed after stop")
+	}
+}
+
+func (s *ENVSuite) TestWatchInterval(c *C) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		ch, stop := Watch([]string{"EK_WATCH_TEST"}, interval)
+
+		time.Sleep(5 * time.Millisecond)
+
+		stop()
+
+		select {
+		case _, ok := <-ch:
+			c.Assert(ok, Equals, false)
+		case <-time.After(time.Second):
+			c.Fatal("Channel is not closed after stop")
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func waitChange(ch <-chan Change) Change {
+	select {
+	case change := <-ch:
+		return change
+	case <-time.After(time.Second):
+		return Change{}
+	}
+}
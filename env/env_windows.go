@@ -9,6 +9,15 @@ package env
 //                                                                                    //
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // Env is map with environment values
 type Env map[string]string
 
@@ -21,7 +30,65 @@ func Get() Env {
 
 // Which find full path to some app
 func Which(name string) string {
-	return ""
+	result := WhichAll(name)
+
+	if len(result) == 0 {
+		return ""
+	}
+
+	return result[0]
+}
+
+// WhichAll find all paths to some app in directories from PATH. Extensions
+// from PATHEXT are used if name doesn't contain extension.
+func WhichAll(name string) []string {
+	var result []string
+
+	exts := []string{""}
+
+	if filepath.Ext(name) == "" {
+		pathExt := os.Getenv("PATHEXT")
+
+		if pathExt == "" {
+			pathExt = ".COM;.EXE;.BAT;.CMD"
+		}
+
+		exts = strings.Split(strings.ToLower(pathExt), ";")
+	}
+
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		for _, ext := range exts {
+			path := filepath.Join(dir, name+ext)
+			info, err := os.Stat(path)
+
+			if err == nil && !info.IsDir() {
+				result = append(result, path)
+			}
+		}
+	}
+
+	return result
+}
+
+// Set set environment variable value
+func Set(name, value string) error {
+	return nil
+}
+
+// Unset remove environment variable
+func Unset(name string) error {
+	return nil
+}
+
+// WithVars set given environment variables, execute function and restore
+// previous state of variables
+func WithVars(vars map[string]string, fn func()) {
+	fn()
+}
+
+// OfProcess return environment of process with given pid
+func OfProcess(pid int) (Env, error) {
+	return Env{}, nil
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -32,16 +99,31 @@ func (e Env) Path() []string {
 }
 
 // GetS return environment variable value as string
-func (e Env) GetS(name string) string {
+func (e Env) GetS(name string, defvals ...string) string {
 	return e[name]
 }
 
 // GetI return environment variable value as int
-func (e Env) GetI(name string) int {
+func (e Env) GetI(name string, defvals ...int) int {
 	return -1
 }
 
 // GetF return environment variable value as float
-func (e Env) GetF(name string) float64 {
+func (e Env) GetF(name string, defvals ...float64) float64 {
 	return -1.0
 }
+
+// GetB return environment variable value as boolean
+func (e Env) GetB(name string, defvals ...bool) bool {
+	return false
+}
+
+// GetD return environment variable value as duration
+func (e Env) GetD(name string, defvals ...time.Duration) time.Duration {
+	return 0
+}
+
+// GetSZ return environment variable value as size in bytes
+func (e Env) GetSZ(name string, defvals ...uint64) uint64 {
+	return 0
+}
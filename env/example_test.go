@@ -9,6 +9,7 @@ package env
 
 import (
 	"fmt"
+	"time"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -28,6 +29,122 @@ func ExampleGet() {
 	fmt.Printf("Integer value %s = %d\n", "INT_VALUE", env.GetI("INT_VALUE"))
 	fmt.Printf("Float value %s = %f\n", "FLOAT_VALUE", env.GetI("FLOAT_VALUE"))
 	fmt.Printf("String value %s = %d\n", "STR_VALUE", env.GetS("STR_VALUE"))
+
+	// Getters support default values which used if variable is empty
+	// or can't be parsed
+	fmt.Printf("Port = %d\n", env.GetI("PORT", 8080))
+	fmt.Printf("Debug = %t\n", env.GetB("DEBUG", false))
+	fmt.Printf("Timeout = %v\n", env.GetD("TIMEOUT", 30*time.Second))
+	fmt.Printf("Max size = %d\n", env.GetSZ("MAX_SIZE", 1024*1024))
+}
+
+func ExampleSet() {
+	Set("MY_APP_MODE", "debug")
+
+	fmt.Println(Get().GetS("MY_APP_MODE"))
+
+	Unset("MY_APP_MODE")
+
+	// Output: debug
+}
+
+func ExampleWithVars() {
+	WithVars(map[string]string{"LANG": "C"}, func() {
+		// LANG is set to C only inside this function
+		fmt.Println(Get().GetS("LANG"))
+	})
+
+	// Output: C
+}
+
+func ExampleLoadFile() {
+	// variables which already defined in environment will not be changed
+	vars, err := LoadFile("/etc/myapp/.env", false)
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	for name, value := range vars {
+		fmt.Printf("%s = %s\n", name, value)
+	}
+}
+
+func ExampleWhichAll() {
+	for _, path := range WhichAll("python") {
+		fmt.Println(path)
+	}
+}
+
+func ExamplePathPrepend() {
+	// add directory to the beginning of PATH
+	PathPrepend("/opt/myapp/bin")
+
+	// remove useless directories and duplicates
+	PathRemove("/usr/games")
+	PathDedup()
+
+	fmt.Println(Get().Path())
+}
+
+func ExampleSnapshot() {
+	snapshot := Snapshot()
+
+	Set("MY_APP_MODE", "debug")
+
+	for _, change := range Diff(snapshot, Snapshot()) {
+		fmt.Printf("%s: %q -> %q\n", change.Name, change.OldValue, change.NewValue)
+	}
+
+	// rollback all changes
+	Restore(snapshot)
+
+	// Output: MY_APP_MODE: "" -> "debug"
+}
+
+func ExampleOfProcess() {
+	envs, err := OfProcess(1234)
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("LANG of process 1234: %s\n", envs.GetS("LANG"))
+}
+
+func ExampleEnv_ExportShell() {
+	envs := Env{"APP_NAME": "My App", "APP_MODE": "debug"}
+
+	fmt.Print(envs.ExportShell("APP_"))
+
+	// Output:
+	// export APP_MODE='debug'
+	// export APP_NAME='My App'
+}
+
+func ExampleEnv_ExportJSON() {
+	envs := Env{"APP_NAME": "My App", "APP_MODE": "debug"}
+
+	data, err := envs.ExportJSON()
+
+	if err != nil {
+		return
+	}
+
+	fmt.Println(string(data))
+
+	// Output: {"APP_MODE":"debug","APP_NAME":"My App"}
+}
+
+func ExampleEnv_WithPrefix() {
+	envs := Env{"MYAPP_PORT": "8080", "MYAPP_HOST": "localhost", "HOME": "/root"}
+	config := envs.WithPrefix("MYAPP_")
+
+	fmt.Printf("%s:%d\n", config.GetS("HOST"), config.GetI("PORT"))
+
+	// Output: localhost:8080
 }
 
 func ExampleWhich() {
@@ -0,0 +1,56 @@
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ExportShell return sorted by name variables as shell export commands
+// (export NAME='value'). If prefix is not empty, only variables with names
+// starting with prefix will be exported.
+func (e Env) ExportShell(prefix string) string {
+	var result []string
+
+	for _, name := range getSortedNames(e) {
+		if prefix != "" && !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		if !isValidVarName(name) {
+			continue
+		}
+
+		result = append(result, "export "+name+"="+quoteShellValue(e[name]))
+	}
+
+	if len(result) == 0 {
+		return ""
+	}
+
+	return strings.Join(result, "\n") + "\n"
+}
+
+// ExportJSON return variables encoded as JSON object
+func (e Env) ExportJSON() ([]byte, error) {
+	if e == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(map[string]string(e))
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// quoteShellValue wrap value in single quotes
+func quoteShellValue(value string) string {
+	return "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,107 @@
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// PathPrepend add given directories to the beginning of PATH. If some
+// directory already present in PATH, it will be moved to the beginning.
+func PathPrepend(dirs ...string) error {
+	path := removePathDirs(getPathDirs(), dirs)
+
+	return setPathDirs(append(cleanPathDirs(dirs), path...))
+}
+
+// PathAppend add given directories to the end of PATH. Directories which
+// already present in PATH will be ignored.
+func PathAppend(dirs ...string) error {
+	path := getPathDirs()
+
+	for _, dir := range cleanPathDirs(dirs) {
+		if !containsPathDir(path, dir) {
+			path = append(path, dir)
+		}
+	}
+
+	return setPathDirs(path)
+}
+
+// PathRemove remove given directories from PATH
+func PathRemove(dirs ...string) error {
+	return setPathDirs(removePathDirs(getPathDirs(), dirs))
+}
+
+// PathDedup remove duplicate and empty entries from PATH
+func PathDedup() error {
+	var result []string
+
+	for _, dir := range getPathDirs() {
+		if dir != "" && !containsPathDir(result, dir) {
+			result = append(result, dir)
+		}
+	}
+
+	return setPathDirs(result)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getPathDirs return slice with directories from PATH
+func getPathDirs() []string {
+	return filepath.SplitList(os.Getenv("PATH"))
+}
+
+// setPathDirs write directories to PATH
+func setPathDirs(dirs []string) error {
+	return os.Setenv("PATH", strings.Join(dirs, string(os.PathListSeparator)))
+}
+
+// removePathDirs return copy of path without given directories
+func removePathDirs(path, dirs []string) []string {
+	var result []string
+
+	dirs = cleanPathDirs(dirs)
+
+	for _, dir := range path {
+		if !containsPathDir(dirs, dir) {
+			result = append(result, dir)
+		}
+	}
+
+	return result
+}
+
+// cleanPathDirs clean directory paths and remove empty values
+func cleanPathDirs(dirs []string) []string {
+	var result []string
+
+	for _, dir := range dirs {
+		if dir != "" {
+			result = append(result, filepath.Clean(dir))
+		}
+	}
+
+	return result
+}
+
+// containsPathDir return true if slice contains given directory
+func containsPathDir(path []string, dir string) bool {
+	for _, d := range path {
+		if d != "" && filepath.Clean(d) == filepath.Clean(dir) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,22 @@
+// +build darwin
+
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// OfProcess return environment of process with given pid
+// (not supported on this system)
+func OfProcess(pid int) (Env, error) {
+	return nil, errors.New("Reading environment of other processes is not supported on this system")
+}
@@ -0,0 +1,72 @@
+// +build freebsd
+
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// sysctl MIB names for kern.proc.env
+const (
+	_CTL_KERN      = 1
+	_KERN_PROC     = 14
+	_KERN_PROC_ENV = 35
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// OfProcess return environment of process with given pid. Reading environment
+// of processes owned by other users requires root privileges.
+func OfProcess(pid int) (Env, error) {
+	mib := []int32{_CTL_KERN, _KERN_PROC, _KERN_PROC_ENV, int32(pid)}
+
+	var size uintptr
+
+	// First call return size of data
+	err := sysctl(mib, nil, &size)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if size == 0 {
+		return Env{}, nil
+	}
+
+	data := make([]byte, size)
+	err = sysctl(mib, &data[0], &size)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseEnvironData(data[:size]), nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// sysctl read sysctl value by MIB
+func sysctl(mib []int32, data *byte, size *uintptr) error {
+	_, _, errno := syscall.Syscall6(
+		syscall.SYS___SYSCTL,
+		uintptr(unsafe.Pointer(&mib[0])), uintptr(len(mib)),
+		uintptr(unsafe.Pointer(data)), uintptr(unsafe.Pointer(size)),
+		0, 0,
+	)
+
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+// +build linux
+
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"io/ioutil"
+	"strconv"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// OfProcess return environment of process with given pid. Reading environment
+// of processes owned by other users requires root privileges.
+func OfProcess(pid int) (Env, error) {
+	data, err := ioutil.ReadFile("/proc/" + strconv.Itoa(pid) + "/environ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseEnvironData(data), nil
+}
@@ -0,0 +1,34 @@
+// +build !windows
+
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+
+	. "pkg.re/check.v1"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func (s *ENVSuite) TestOfProcess(c *C) {
+	envs, err := OfProcess(os.Getpid())
+
+	c.Assert(err, IsNil)
+	c.Assert(envs, NotNil)
+	c.Assert(envs.GetS("HOME"), Equals, os.Getenv("HOME"))
+
+	_, err = OfProcess(999999)
+
+	c.Assert(err, NotNil)
+
+	envs = parseEnvironData([]byte("A=1\x00B=2=3\x00\x00=4\x00"))
+
+	c.Assert(envs, DeepEquals, Env{"A": "1", "B": "2=3"})
+}
@@ -0,0 +1,113 @@
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Change types
+const (
+	CHANGE_ADDED = iota
+	CHANGE_REMOVED
+	CHANGE_MODIFIED
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Change contains info about environment variable change
+type Change struct {
+	Name     string // Variable name
+	Type     int    // Change type
+	OldValue string // Previous value
+	NewValue string // Current value
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Snapshot return copy of current process environment
+func Snapshot() Env {
+	result := make(Env)
+
+	for _, ev := range os.Environ() {
+		evs := strings.SplitN(ev, "=", 2)
+
+		if len(evs) == 2 {
+			result[evs[0]] = evs[1]
+		}
+	}
+
+	return result
+}
+
+// Diff return sorted by name slice with changes between two environments
+func Diff(a, b Env) []Change {
+	var result []Change
+
+	for _, name := range getSortedNames(a, b) {
+		oldValue, oldExist := a[name]
+		newValue, newExist := b[name]
+
+		switch {
+		case !oldExist:
+			result = append(result, Change{name, CHANGE_ADDED, "", newValue})
+		case !newExist:
+			result = append(result, Change{name, CHANGE_REMOVED, oldValue, ""})
+		case oldValue != newValue:
+			result = append(result, Change{name, CHANGE_MODIFIED, oldValue, newValue})
+		}
+	}
+
+	return result
+}
+
+// Restore restore process environment from snapshot. All variables which
+// are not present in snapshot will be removed.
+func Restore(snapshot Env) error {
+	for _, change := range Diff(snapshot, Snapshot()) {
+		var err error
+
+		if change.Type == CHANGE_ADDED {
+			err = os.Unsetenv(change.Name)
+		} else {
+			err = os.Setenv(change.Name, change.OldValue)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getSortedNames return sorted slice with unique names of all variables
+func getSortedNames(envs ...Env) []string {
+	var result []string
+
+	names := make(map[string]bool)
+
+	for _, env := range envs {
+		for name := range env {
+			if !names[name] {
+				names[name] = true
+				result = append(result, name)
+			}
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,58 @@
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// CaseInsensitive is flag for case-insensitive lookup of variables in getters
+// and WithPrefix
+var CaseInsensitive = false
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// WithPrefix return new Env with variables with names starting with given
+// prefix. Prefix is removed from variable names.
+func (e Env) WithPrefix(prefix string) Env {
+	result := make(Env)
+
+	for name, value := range e {
+		switch {
+		case strings.HasPrefix(name, prefix):
+			result[name[len(prefix):]] = value
+		case CaseInsensitive && len(name) >= len(prefix) && strings.EqualFold(name[:len(prefix)], prefix):
+			result[name[len(prefix):]] = value
+		}
+	}
+
+	delete(result, "")
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// get return variable value, in case-insensitive mode exact match is preferred
+func (e Env) get(name string) string {
+	value, ok := e[name]
+
+	if ok || !CaseInsensitive {
+		return value
+	}
+
+	for n, v := range e {
+		if strings.EqualFold(n, name) {
+			return v
+		}
+	}
+
+	return ""
+}
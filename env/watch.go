@@ -0,0 +1,86 @@
+package env
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// MIN_WATCH_INTERVAL is minimal interval for polling variables
+const MIN_WATCH_INTERVAL = time.Millisecond
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Watch start polling given variables with given interval and return channel
+// with info about their changes and function for stopping polling. Channel
+// is closed after polling is stopped. Interval less than MIN_WATCH_INTERVAL
+// is replaced by MIN_WATCH_INTERVAL.
+func Watch(names []string, interval time.Duration) (<-chan Change, func()) {
+	if interval < MIN_WATCH_INTERVAL {
+		interval = MIN_WATCH_INTERVAL
+	}
+
+	ch := make(chan Change)
+	stop := make(chan struct{})
+
+	var once sync.Once
+
+	go watchVars(names, interval, ch, stop)
+
+	return ch, func() { once.Do(func() { close(stop) }) }
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// watchVars check variables and send changes to channel until stop
+// channel is closed
+func watchVars(names []string, interval time.Duration, ch chan Change, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+
+	defer ticker.Stop()
+	defer close(ch)
+
+	prev := getVars(names)
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		cur := getVars(names)
+
+		for _, change := range Diff(prev, cur) {
+			select {
+			case ch <- change:
+			case <-stop:
+				return
+			}
+		}
+
+		prev = cur
+	}
+}
+
+// getVars return current values of given variables
+func getVars(names []string) Env {
+	result := make(Env)
+
+	for _, name := range names {
+		if value, ok := os.LookupEnv(name); ok {
+			result[name] = value
+		}
+	}
+
+	return result
+}
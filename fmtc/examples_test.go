@@ -52,6 +52,15 @@ func ExamplePrintln() {
 	Println("{r@}red{!}")
 	Println("{g@}green{!}")
 
+	// italic
+	Println("{r&}red{!}")
+
+	// strikethrough
+	Println("{r=}red{!}")
+
+	// several modificators and background in one tag
+	Println("{r*_Y}bold underlined red on yellow{!}")
+
 	// background color
 	Println("{D}black{!}")
 	Println("{R}red{!}")
@@ -80,6 +89,61 @@ func ExamplePrintf() {
 	}
 }
 
+func ExampleSprintf() {
+	// Render color tags to string
+	msg := Sprintf("Disk usage: {r}%d%%{!}", 95)
+
+	fmt.Println(msg)
+}
+
+func ExampleSprintln() {
+	msg := Sprintln("{y}Warning:{!}", "low disk space")
+
+	fmt.Print(msg)
+}
+
+func ExampleTPrintf() {
+	for i := 0; i <= 100; i += 10 {
+		// Every message replaces previous one
+		TPrintf("Progress: {g}%d%%{!}", i)
+	}
+
+	TPrintln("{g}Done{!}")
+}
+
+func ExampleLPrintf() {
+	// Print no more than 12 symbols
+	LPrintf(12, "{y}Very long message which will be truncated{!}")
+	NewLine()
+}
+
+func ExampleIf() {
+	verbose := true
+
+	// Message will be printed only if verbose is true
+	If(verbose).Printf("{s}Reading configuration file…{!}\n")
+}
+
+func ExampleV() {
+	Verbosity = 2
+
+	// Message will be printed because verbosity level is 2
+	V(1).Println("{s}Connecting to server{!}")
+
+	// Message will not be printed
+	V(3).Println("{s}Sending request with headers…{!}")
+}
+
+func ExamplePrintln_profile() {
+	// Use profile for terminals with light background, so white
+	// and light colors will be replaced by dark ones
+	Profile = PROFILE_LIGHT
+
+	Println("{w}This text is readable on white background{!}")
+
+	Profile = PROFILE_DARK
+}
+
 func ExampleBell() {
 	// terminal bell
 	Bell()
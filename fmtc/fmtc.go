@@ -13,6 +13,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"strconv"
+	"strings"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -21,6 +24,13 @@ const (
 	_CODE_RESET     = "\033[0m"
 	_CODE_BACKSPACE = "\b"
 	_CODE_BELL      = "\a"
+	_CODE_CLEAN     = "\r\033[0K"
+)
+
+// Color profiles
+const (
+	PROFILE_DARK  = 0 // Profile for terminals with dark background
+	PROFILE_LIGHT = 1 // Profile for terminals with light background
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -30,6 +40,11 @@ type T struct {
 	size int
 }
 
+// P is struct can be used for conditional printing
+type P struct {
+	enabled bool
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 var codes = map[rune]int{
@@ -38,9 +53,11 @@ var codes = map[rune]int{
 	'!': 0,  // Default
 	'*': 1,  // Bold
 	'^': 2,  // Dim
+	'&': 3,  // Italic
 	'_': 4,  // Underline
 	'~': 5,  // Blink
 	'@': 7,  // Reverse
+	'=': 9,  // Strikethrough
 
 	// Text
 	'd': 30, // Black (Dark)
@@ -65,10 +82,50 @@ var codes = map[rune]int{
 	'W': 107, // White
 }
 
+// lightProfileColors contains colors which must be replaced for terminals
+// with light background
+var lightProfileColors = map[int]int{
+	97: 30, // White -> Black
+	37: 90, // Gray -> Dark gray
+	92: 32, // Light green -> Green
+	93: 33, // Light yellow -> Yellow
+	96: 36, // Light cyan -> Cyan
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// DisableColors disable all colors and modificators in output
-var DisableColors = false
+// DisableColors disable all colors and modificators in output (by default
+// colors are disabled if NO_COLOR environment variable is set)
+var DisableColors = os.Getenv("NO_COLOR") != ""
+
+// ForceColors enable colors even if output is not a terminal (by default
+// colors are forced if CLICOLOR_FORCE environment variable is set and not
+// equal to 0)
+var ForceColors = os.Getenv("CLICOLOR_FORCE") != "" && os.Getenv("CLICOLOR_FORCE") != "0"
+
+// Verbosity is current verbosity level used by V
+var Verbosity = 0
+
+// Profile is color profile used for rendering color tags (by default profile
+// is detected using COLORFGBG environment variable)
+var Profile = detectProfile()
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// stdoutIsTerminal is true if standard output is a terminal
+var stdoutIsTerminal = isTerminal(os.Stdout)
+
+// term is name of current terminal
+var term = os.Getenv("TERM")
+
+// unsupportedModificators contains modificators which are not supported
+// by some terminals
+var unsupportedModificators = map[string][]int{
+	"linux":  {3, 9},
+	"vt100":  {2, 3, 9},
+	"vt220":  {2, 3, 9},
+	"cygwin": {3, 9},
+}
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
@@ -76,21 +133,21 @@ var DisableColors = false
 // output. Spaces are always added between operands and a newline is appended. It
 // returns the number of bytes written and any write error encountered.
 func Println(a ...interface{}) (int, error) {
-	applyColors(&a, DisableColors)
+	applyColors(&a, isColorsDisabled(os.Stdout))
 	return fmt.Println(a...)
 }
 
 // Printf formats according to a format specifier and writes to standard output. It
 // returns the number of bytes written and any write error encountered.
 func Printf(f string, a ...interface{}) (int, error) {
-	return fmt.Printf(searchColors(f, DisableColors), a...)
+	return fmt.Printf(searchColors(f, isColorsDisabled(os.Stdout)), a...)
 }
 
 // Fprint formats using the default formats for its operands and writes to w.
 // Spaces are added between operands when neither is a string. It returns the
 // number of bytes written and any write error encountered.
 func Fprint(w io.Writer, a ...interface{}) (int, error) {
-	applyColors(&a, DisableColors)
+	applyColors(&a, isColorsDisabled(w))
 	return fmt.Fprint(w, a...)
 }
 
@@ -98,14 +155,14 @@ func Fprint(w io.Writer, a ...interface{}) (int, error) {
 // Spaces are always added between operands and a newline is appended. It returns
 // the number of bytes written and any write error encountered.
 func Fprintln(w io.Writer, a ...interface{}) (int, error) {
-	applyColors(&a, DisableColors)
+	applyColors(&a, isColorsDisabled(w))
 	return fmt.Fprintln(w, a...)
 }
 
 // Fprintf formats according to a format specifier and writes to w. It returns
 // the number of bytes written and any write error encountered.
 func Fprintf(w io.Writer, f string, a ...interface{}) (int, error) {
-	return fmt.Fprintf(w, searchColors(f, DisableColors), a...)
+	return fmt.Fprintf(w, searchColors(f, isColorsDisabled(w)), a...)
 }
 
 // Sprint formats using the default formats for its operands and returns the
@@ -115,6 +172,14 @@ func Sprint(a ...interface{}) string {
 	return fmt.Sprint(a...)
 }
 
+// Sprintln formats using the default formats for its operands and returns the
+// resulting string. Spaces are always added between operands and a newline is
+// appended.
+func Sprintln(a ...interface{}) string {
+	applyColors(&a, DisableColors)
+	return fmt.Sprintln(a...)
+}
+
 // Sprintf formats according to a format specifier and returns the resulting
 // string.
 func Sprintf(f string, a ...interface{}) string {
@@ -137,6 +202,38 @@ func Clean(s string) string {
 	return searchColors(s, true)
 }
 
+// TPrintf remove previous temporary line (if printed) and print new temporary
+// line. Message must not contain newlines, because only current line of
+// terminal is cleaned.
+func TPrintf(f string, a ...interface{}) (int, error) {
+	return fmt.Printf(_CODE_CLEAN+searchColors(f, isColorsDisabled(os.Stdout)), a...)
+}
+
+// TPrintln remove previous temporary line (if printed) and print message
+// with newline
+func TPrintln(a ...interface{}) (int, error) {
+	fmt.Print(_CODE_CLEAN)
+	return Println(a...)
+}
+
+// LPrintf formats according to a format specifier and writes to standard output
+// no more than given number of symbols (color tags are not counted)
+func LPrintf(limit int, f string, a ...interface{}) (int, error) {
+	s := fmt.Sprintf(searchColors(f, isColorsDisabled(os.Stdout)), a...)
+	return fmt.Print(truncateANSI(s, limit))
+}
+
+// If return printer which prints messages only if given condition is true
+func If(cond bool) P {
+	return P{cond}
+}
+
+// V return printer which prints messages only if current verbosity level
+// (Verbosity) is greater than or equal to given level
+func V(level int) P {
+	return P{Verbosity >= level}
+}
+
 // Bell print alert symbol
 func Bell() {
 	fmt.Printf(_CODE_BELL)
@@ -152,7 +249,7 @@ func (t *T) Printf(f string, a ...interface{}) (int, error) {
 
 	t.size = len(fmt.Sprintf(searchColors(f, true), a...))
 
-	return fmt.Printf(searchColors(f, DisableColors), a...)
+	return fmt.Printf(searchColors(f, isColorsDisabled(os.Stdout)), a...)
 }
 
 // Println remove previous message (if printed) and print new message
@@ -168,16 +265,58 @@ func (t *T) Println(a ...interface{}) (int, error) {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// Printf formats according to a format specifier and writes to standard output
+// if printer is enabled
+func (p P) Printf(f string, a ...interface{}) (int, error) {
+	if !p.enabled {
+		return 0, nil
+	}
+
+	return Printf(f, a...)
+}
+
+// Println formats using the default formats for its operands and writes to standard
+// output if printer is enabled
+func (p P) Println(a ...interface{}) (int, error) {
+	if !p.enabled {
+		return 0, nil
+	}
+
+	return Println(a...)
+}
+
+// Fprintf formats according to a format specifier and writes to w if printer
+// is enabled
+func (p P) Fprintf(w io.Writer, f string, a ...interface{}) (int, error) {
+	if !p.enabled {
+		return 0, nil
+	}
+
+	return Fprintf(w, f, a...)
+}
+
+// Fprintln formats using the default formats for its operands and writes to w
+// if printer is enabled
+func (p P) Fprintln(w io.Writer, a ...interface{}) (int, error) {
+	if !p.enabled {
+		return 0, nil
+	}
+
+	return Fprintln(w, a...)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 func tag2ANSI(tag string, clean bool) string {
 	if clean {
 		return ""
 	}
 
 	var (
-		modificator = 0
-		charColor   = 39
-		bgColor     = 49
-		light       = false
+		modificators []string
+		charColor    = 39
+		bgColor      = 49
+		light        = false
 	)
 
 	for _, key := range tag {
@@ -186,8 +325,12 @@ func tag2ANSI(tag string, clean bool) string {
 		switch key {
 		case '-':
 			light = true
-		case '!', '*', '^', '_', '~', '@':
-			modificator = code
+		case '!':
+			modificators = nil
+		case '*', '^', '&', '_', '~', '@', '=':
+			if isModificatorSupported(code) {
+				modificators = append(modificators, strconv.Itoa(code))
+			}
 		case 'd', 'r', 'g', 'y', 'b', 'm', 'c', 's', 'w':
 			charColor = code
 		case 'D', 'R', 'G', 'Y', 'B', 'M', 'C', 'S', 'W':
@@ -206,7 +349,19 @@ func tag2ANSI(tag string, clean bool) string {
 		}
 	}
 
-	return fmt.Sprintf("\033[%d;%d;%dm", modificator, charColor, bgColor)
+	// Background color is not remapped, so colors defined for custom
+	// background must be used as is
+	if Profile == PROFILE_LIGHT && bgColor == 49 {
+		if color, ok := lightProfileColors[charColor]; ok {
+			charColor = color
+		}
+	}
+
+	if len(modificators) == 0 {
+		modificators = []string{"0"}
+	}
+
+	return fmt.Sprintf("\033[%s;%d;%dm", strings.Join(modificators, ";"), charColor, bgColor)
 }
 
 func replaceColorTags(input, output *bytes.Buffer, clean bool) bool {
@@ -293,6 +448,101 @@ func applyColors(a *[]interface{}, clean bool) {
 	}
 }
 
+// truncateANSI cut string to given number of symbols ignoring
+// ANSI escape sequences
+func truncateANSI(s string, limit int) string {
+	var (
+		size    int
+		escaped bool
+		hasANSI bool
+	)
+
+	for index, r := range s {
+		switch {
+		case escaped:
+			escaped = r != 'm'
+			continue
+		case r == '\033':
+			escaped, hasANSI = true, true
+			continue
+		}
+
+		size++
+
+		if size > limit {
+			if hasANSI {
+				return s[:index] + _CODE_RESET
+			}
+
+			return s[:index]
+		}
+	}
+
+	return s
+}
+
+// isColorsDisabled return true if colors must be removed from output
+// written to given writer. Only files (os.File) are checked, so colors
+// will be written to other writers as is.
+func isColorsDisabled(w io.Writer) bool {
+	switch {
+	case DisableColors:
+		return true
+	case ForceColors:
+		return false
+	case term == "dumb":
+		return true
+	}
+
+	f, ok := w.(*os.File)
+
+	if !ok {
+		return false
+	}
+
+	if f == os.Stdout {
+		return !stdoutIsTerminal
+	}
+
+	return !isTerminal(f)
+}
+
+// detectProfile return color profile based on terminal background color
+// defined in COLORFGBG environment variable (e.g. "15;0" or "0;default;15")
+func detectProfile() int {
+	colorfgbg := os.Getenv("COLORFGBG")
+
+	if colorfgbg == "" {
+		return PROFILE_DARK
+	}
+
+	bg, err := strconv.Atoi(colorfgbg[strings.LastIndex(colorfgbg, ";")+1:])
+
+	if err != nil {
+		return PROFILE_DARK
+	}
+
+	// Colors 7 (light gray) and 9-15 (light colors) are used as
+	// background in terminals with light theme
+	if bg == 7 || (bg >= 9 && bg <= 15) {
+		return PROFILE_LIGHT
+	}
+
+	return PROFILE_DARK
+}
+
+// isModificatorSupported return true if current terminal supports
+// given modificator
+func isModificatorSupported(code int) bool {
+	for _, c := range unsupportedModificators[term] {
+		if c == code {
+			return false
+		}
+	}
+
+	return true
+}
+
 func getSymbols(symbol string, count int) string {
 	result := ""
 
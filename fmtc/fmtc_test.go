@@ -10,6 +10,8 @@ package fmtc
 import (
 	"bytes"
 	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	. "pkg.re/check.v1"
@@ -58,6 +60,29 @@ func (s *FormatSuite) TestBackgrounds(c *C) {
 func (s *FormatSuite) TestSpecial(c *C) {
 	c.Assert(Sprint("{_}W{!}"), Equals, "\x1b[4;39;49mW\x1b[0m")
 	c.Assert(Sprint("{*}W{!}"), Equals, "\x1b[1;39;49mW\x1b[0m")
+	c.Assert(Sprint("{^}W{!}"), Equals, "\x1b[2;39;49mW\x1b[0m")
+	c.Assert(Sprint("{~}W{!}"), Equals, "\x1b[5;39;49mW\x1b[0m")
+	c.Assert(Sprint("{@}W{!}"), Equals, "\x1b[7;39;49mW\x1b[0m")
+
+	curTerm := term
+	term = "xterm-256color"
+
+	c.Assert(Sprint("{&}W{!}"), Equals, "\x1b[3;39;49mW\x1b[0m")
+	c.Assert(Sprint("{=}W{!}"), Equals, "\x1b[9;39;49mW\x1b[0m")
+	c.Assert(Sprint("{*_}W{!}"), Equals, "\x1b[1;4;39;49mW\x1b[0m")
+	c.Assert(Sprint("{r*_Y}W{!}"), Equals, "\x1b[1;4;31;43mW\x1b[0m")
+	c.Assert(Sprint("{*!}W{!}"), Equals, "\x1b[0;39;49mW\x1b[0m")
+
+	term = "linux"
+
+	c.Assert(Sprint("{&}W{!}"), Equals, "\x1b[0;39;49mW\x1b[0m")
+	c.Assert(Sprint("{*&=}W{!}"), Equals, "\x1b[1;39;49mW\x1b[0m")
+
+	term = "dumb"
+
+	c.Assert(isColorsDisabled(os.Stdout), Equals, true)
+
+	term = curTerm
 }
 
 func (s *FormatSuite) TestParsing(c *C) {
@@ -116,6 +141,12 @@ func (s *FormatSuite) TestClean(c *C) {
 func (s *FormatSuite) TestMethods(c *C) {
 	c.Assert(Errorf("Test %s", "OK"), DeepEquals, errors.New("Test OK"))
 	c.Assert(Sprintf("Test %s", "OK"), Equals, "Test OK")
+	c.Assert(Sprintf("{r}Test %s{!}", "OK"), Equals, "\x1b[0;31;49mTest OK\x1b[0m")
+	c.Assert(Sprintln("{r}Test{!}", "OK"), Equals, "\x1b[0;31;49mTest\x1b[0m OK\n")
+
+	DisableColors = true
+	c.Assert(Sprintln("{r}Test{!}", "OK"), Equals, "Test OK\n")
+	DisableColors = false
 
 	w := bytes.NewBufferString("")
 
@@ -138,6 +169,124 @@ func (s *FormatSuite) TestMethods(c *C) {
 	Printf("TEST %s\n", "OK")
 }
 
+func (s *FormatSuite) TestAutoDisable(c *C) {
+	w := bytes.NewBufferString("")
+
+	Fprint(w, "{r}W{!}")
+
+	c.Assert(w.String(), Equals, "\x1b[0;31;49mW\x1b[0m")
+
+	fd, err := ioutil.TempFile(c.MkDir(), "fmtc")
+
+	c.Assert(err, IsNil)
+
+	defer fd.Close()
+
+	Fprint(fd, "{r}W{!}")
+	Fprintln(fd, "{g}W{!}")
+	Fprintf(fd, "{y}%s{!}", "W")
+
+	ForceColors = true
+	Fprint(fd, "{r}W{!}")
+	ForceColors = false
+
+	data, err := ioutil.ReadFile(fd.Name())
+
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, "WW\nW\x1b[0;31;49mW\x1b[0m")
+
+	c.Assert(isColorsDisabled(w), Equals, false)
+
+	DisableColors = true
+	c.Assert(isColorsDisabled(w), Equals, true)
+	DisableColors = false
+
+	null, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+
+	c.Assert(err, IsNil)
+
+	defer null.Close()
+
+	c.Assert(isTerminal(fd), Equals, false)
+	c.Assert(isTerminal(null), Equals, false)
+	c.Assert(isColorsDisabled(null), Equals, true)
+}
+
+func (s *FormatSuite) TestConditional(c *C) {
+	w := &bytes.Buffer{}
+
+	If(false).Fprintf(w, "{r}%s{!}", "A")
+	If(true).Fprintf(w, "{r}%s{!}", "B")
+	If(false).Fprintln(w, "C")
+	If(true).Fprintln(w, "D")
+
+	c.Assert(w.String(), Equals, "\x1b[0;31;49mB\x1b[0mD\n")
+
+	w.Reset()
+
+	Verbosity = 1
+
+	V(0).Fprintln(w, "0")
+	V(1).Fprintln(w, "1")
+	V(2).Fprintln(w, "2")
+
+	c.Assert(w.String(), Equals, "0\n1\n")
+
+	Verbosity = 0
+
+	n, err := V(1).Printf("TEST")
+
+	c.Assert(n, Equals, 0)
+	c.Assert(err, IsNil)
+
+	n, err = If(false).Println("TEST")
+
+	c.Assert(n, Equals, 0)
+	c.Assert(err, IsNil)
+
+	If(true).Printf("TEST %s\n", "OK")
+	V(0).Println("TEST OK")
+}
+
+func (s *FormatSuite) TestProfiles(c *C) {
+	Profile = PROFILE_LIGHT
+
+	c.Assert(Sprint("{w}W{!}"), Equals, "\x1b[0;30;49mW\x1b[0m")
+	c.Assert(Sprint("{s}S{!}"), Equals, "\x1b[0;90;49mS\x1b[0m")
+	c.Assert(Sprint("{y-}Y{!}"), Equals, "\x1b[0;33;49mY\x1b[0m")
+	c.Assert(Sprint("{r}R{!}"), Equals, "\x1b[0;31;49mR\x1b[0m")
+	c.Assert(Sprint("{wD}W{!}"), Equals, "\x1b[0;97;40mW\x1b[0m")
+
+	Profile = PROFILE_DARK
+
+	c.Assert(Sprint("{w}W{!}"), Equals, "\x1b[0;97;49mW\x1b[0m")
+
+	colorfgbg := os.Getenv("COLORFGBG")
+
+	os.Setenv("COLORFGBG", "0;15")
+	c.Assert(detectProfile(), Equals, PROFILE_LIGHT)
+	os.Setenv("COLORFGBG", "0;default;7")
+	c.Assert(detectProfile(), Equals, PROFILE_LIGHT)
+	os.Setenv("COLORFGBG", "15;0")
+	c.Assert(detectProfile(), Equals, PROFILE_DARK)
+	os.Setenv("COLORFGBG", "15;default")
+	c.Assert(detectProfile(), Equals, PROFILE_DARK)
+	os.Setenv("COLORFGBG", "")
+	c.Assert(detectProfile(), Equals, PROFILE_DARK)
+
+	os.Setenv("COLORFGBG", colorfgbg)
+}
+
+func (s *FormatSuite) TestTruncate(c *C) {
+	c.Assert(truncateANSI("", 3), Equals, "")
+	c.Assert(truncateANSI("Test", 10), Equals, "Test")
+	c.Assert(truncateANSI("Test", 2), Equals, "Te")
+	c.Assert(truncateANSI("Тест", 2), Equals, "Те")
+	c.Assert(truncateANSI(Sprint("{r}Test{!}"), 4), Equals, "\x1b[0;31;49mTest\x1b[0m")
+	c.Assert(truncateANSI(Sprint("{r}Test{!} OK"), 2), Equals, "\x1b[0;31;49mTe\x1b[0m")
+	c.Assert(truncateANSI(Sprint("{r}Test{!} OK"), 0), Equals, "\x1b[0;31;49m\x1b[0m")
+}
+
 func (s *FormatSuite) TestAux(c *C) {
 	t := &T{}
 
@@ -146,6 +295,12 @@ func (s *FormatSuite) TestAux(c *C) {
 
 	t.Println("TEST OK")
 
+	TPrintf("TEST %s", "OK")
+	TPrintf("TEST %s", "OK")
+	TPrintln("TEST OK")
+
+	LPrintf(4, "TEST %s\n", "OK")
+
 	Bell()
 	NewLine()
 }
@@ -0,0 +1,29 @@
+// +build !linux,!darwin,!freebsd,!windows
+
+package fmtc
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// isTerminal return true if given file is a character device (on these
+// systems we can't check terminal attributes, so any character device is
+// treated as a terminal)
+func isTerminal(f *os.File) bool {
+	stat, err := f.Stat()
+
+	if err != nil {
+		return false
+	}
+
+	return stat.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,30 @@
+// +build linux darwin freebsd
+
+package fmtc
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// isTerminal return true if given file is a terminal
+func isTerminal(f *os.File) bool {
+	state := &syscall.Termios{}
+
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL, f.Fd(), _IOCTL_GETATTR,
+		uintptr(unsafe.Pointer(state)),
+	)
+
+	return errno == 0
+}
@@ -0,0 +1,22 @@
+package fmtc
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// isTerminal return true if given file is a console
+func isTerminal(f *os.File) bool {
+	var mode uint32
+
+	return syscall.GetConsoleMode(syscall.Handle(f.Fd()), &mode) == nil
+}
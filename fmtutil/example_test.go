@@ -9,6 +9,8 @@ package fmtutil
 
 import (
 	"fmt"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -33,6 +35,15 @@ func ExampleSeparator() {
 	Separator(true, "MY SEPARATOR")
 }
 
+func ExampleHeader() {
+	// Print header with newlines before and after it
+	Header("CONFIGURATION")
+
+	// Or render header and separator as strings and print them yourself
+	fmtc.Println(RenderHeader("CONFIGURATION"))
+	fmtc.Println(RenderSeparator("Network"))
+}
+
 func ExamplePrettyNum() {
 	var (
 		n1 int     = 10
@@ -77,6 +88,12 @@ func ExamplePrettySize() {
 	// 46361936461 → 43.2 GB
 }
 
+func ExamplePrettyPerc() {
+	fmt.Println(PrettyPerc(12.3456))
+
+	// Output: 12.3%
+}
+
 func ExampleParseSize() {
 	s1 := "160"
 	s2 := "34Mb"
@@ -115,6 +132,39 @@ func ExampleWrap() {
 	)
 }
 
+func ExamplePadLeft() {
+	fmt.Printf("[%s]\n", PadLeft("{g}OK{!}", 6))
+
+	// Output: [    {g}OK{!}]
+}
+
+func ExamplePadRight() {
+	fmt.Printf("[%s]\n", PadRight("{r}ERROR{!}", 8))
+
+	// Output: [{r}ERROR{!}   ]
+}
+
+func ExampleCenter() {
+	fmt.Printf("[%s]\n", Center("Title", 11))
+
+	// Output: [   Title   ]
+}
+
+func ExampleColorizeByValue() {
+	thresholds := []Threshold{
+		{0, "{g}"},
+		{70, "{y}"},
+		{90, "{r}"},
+	}
+
+	cpuUsage := 76.3
+	colorTag := ColorizeByValue(cpuUsage, thresholds)
+
+	fmt.Printf("%sCPU: %s{!}\n", colorTag, PrettyPerc(cpuUsage))
+
+	// Output: {y}CPU: 76.3%{!}
+}
+
 func ExampleColorizePassword() {
 	password := ">+XY!b3Rog"
 
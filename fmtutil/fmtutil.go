@@ -26,6 +26,15 @@ const (
 	_TERA = 1099511627776
 )
 
+// Threshold contains value threshold and fmtc color tag used
+// for values greater than or equal to threshold
+type Threshold struct {
+	Value    float64
+	ColorTag string
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // OrderSeparator default order separator
 var OrderSeparator = ","
 
@@ -39,6 +48,11 @@ func PrettyNum(i interface{}) string {
 	return getPrettyNum(i)
 }
 
+// PrettyPerc show pretty percentage (e.g. 12.3456 -> 12.3%)
+func PrettyPerc(i float64) string {
+	return formatFloat(Float(i)) + "%"
+}
+
 // PrettySize show pretty size (e.g. 1478182 -> 1.34 Mb)
 func PrettySize(i interface{}) string {
 	var f float64
@@ -62,17 +76,19 @@ func PrettySize(i interface{}) string {
 		f = i.(float64)
 	}
 
+	abs := math.Abs(f)
+
 	switch {
-	case f >= _TERA:
-		return fmt.Sprintf("%g", Float(f/_TERA)) + SizeSeparator + "TB"
-	case f >= _GIGA:
-		return fmt.Sprintf("%g", Float(f/_GIGA)) + SizeSeparator + "GB"
-	case f >= _MEGA:
-		return fmt.Sprintf("%g", Float(f/_MEGA)) + SizeSeparator + "MB"
-	case f >= _KILO:
-		return fmt.Sprintf("%g", Float(f/_KILO)) + SizeSeparator + "KB"
+	case abs >= _TERA:
+		return formatFloat(Float(f/_TERA)) + SizeSeparator + "TB"
+	case abs >= _GIGA:
+		return formatFloat(Float(f/_GIGA)) + SizeSeparator + "GB"
+	case abs >= _MEGA:
+		return formatFloat(Float(f/_MEGA)) + SizeSeparator + "MB"
+	case abs >= _KILO:
+		return formatFloat(Float(f/_KILO)) + SizeSeparator + "KB"
 	default:
-		return fmt.Sprintf("%d", i) + SizeSeparator + "B"
+		return formatFloat(math.Trunc(f)) + SizeSeparator + "B"
 	}
 }
 
@@ -124,14 +140,15 @@ func ParseSize(size string) uint64 {
 
 // Float floating number pretty formating
 func Float(f float64) float64 {
-	if f < 10.0 {
+	if math.Abs(f) < 10.0 {
 		return mathutil.Round(f, 2)
 	}
 
 	return mathutil.Round(f, 1)
 }
 
-// Wrap wraps text using max line length
+// Wrap wraps text using max line length (fmtc color tags and ANSI escape
+// sequences are not counted in line length)
 func Wrap(text, indent string, maxLineLength int) string {
 	var (
 		result = ""
@@ -144,7 +161,7 @@ func Wrap(text, indent string, maxLineLength int) string {
 			wordSlice := strings.Split(word, "\n")
 
 			if len(wordSlice) == 3 {
-				if len(indent+line+wordSlice[0]) > maxLineLength {
+				if Width(indent+line+wordSlice[0]) > maxLineLength {
 					result += indent + line + "\n" + indent + wordSlice[0] + "\n\n"
 				} else {
 					result += indent + line + wordSlice[0] + "\n\n"
@@ -158,7 +175,7 @@ func Wrap(text, indent string, maxLineLength int) string {
 			}
 		}
 
-		if len(indent+line+word) > maxLineLength {
+		if Width(indent+line+word) > maxLineLength {
 			result += indent + line + "\n"
 			line = word + " "
 
@@ -177,6 +194,68 @@ func Wrap(text, indent string, maxLineLength int) string {
 	return result
 }
 
+// PadLeft add spaces to the left of given string to given size (fmtc color
+// tags and ANSI escape sequences are not counted in string size)
+func PadLeft(s string, size int) string {
+	pad := size - Width(s)
+
+	if pad <= 0 {
+		return s
+	}
+
+	return strings.Repeat(" ", pad) + s
+}
+
+// PadRight add spaces to the right of given string to given size (fmtc color
+// tags and ANSI escape sequences are not counted in string size)
+func PadRight(s string, size int) string {
+	pad := size - Width(s)
+
+	if pad <= 0 {
+		return s
+	}
+
+	return s + strings.Repeat(" ", pad)
+}
+
+// Center add spaces to both sides of given string to given size (fmtc color
+// tags and ANSI escape sequences are not counted in string size)
+func Center(s string, size int) string {
+	pad := size - Width(s)
+
+	if pad <= 0 {
+		return s
+	}
+
+	return strings.Repeat(" ", pad/2) + s + strings.Repeat(" ", pad-pad/2)
+}
+
+// ColorizeByValue return fmtc color tag of highest threshold which is less than
+// or equal to given value (e.g. for 0 -> {g}, 70 -> {y}, 90 -> {r} thresholds
+// and value 75.5 {y} will be returned). If value is less than all thresholds
+// empty string will be returned.
+func ColorizeByValue(value float64, thresholds []Threshold) string {
+	var (
+		result  string
+		current float64
+		found   bool
+	)
+
+	for _, threshold := range thresholds {
+		if value < threshold.Value {
+			continue
+		}
+
+		if !found || threshold.Value >= current {
+			result = threshold.ColorTag
+			current = threshold.Value
+			found = true
+		}
+	}
+
+	return result
+}
+
 // ColorizePassword add different fmtc color tags for numbers and letters
 func ColorizePassword(password, letterTag, numTag, specialTag string) string {
 	var (
@@ -223,6 +302,11 @@ func CountDigits(i int) int {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// formatFloat format float without exponent
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
 func getPrettyNum(i interface{}) string {
 	var nStr string
 	var isFloat bool
@@ -255,6 +339,10 @@ func getPrettyNum(i interface{}) string {
 }
 
 func appendPrettySymbol(s string) string {
+	if strings.HasPrefix(s, "-") {
+		return "-" + appendPrettySymbol(s[1:])
+	}
+
 	l := len(s)
 	r := l % 3
 
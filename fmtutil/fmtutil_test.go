@@ -8,6 +8,7 @@ package fmtutil
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"strings"
 	"testing"
 
 	. "pkg.re/check.v1"
@@ -32,6 +33,8 @@ func (s *FmtUtilSuite) TestPretyNum(c *C) {
 	c.Assert(PrettyNum(2500.50), Equals, "2,500.50")
 	c.Assert(PrettyNum(1.23), Equals, "1.23")
 	c.Assert(PrettyNum(-1000), Equals, "-1,000")
+	c.Assert(PrettyNum(-100000), Equals, "-100,000")
+	c.Assert(PrettyNum(-123456.78), Equals, "-123,456.78")
 }
 
 func (s *FmtUtilSuite) TestPretySize(c *C) {
@@ -51,6 +54,21 @@ func (s *FmtUtilSuite) TestPretySize(c *C) {
 	c.Assert(PrettySize(uint64(3000125)), Equals, "2.86MB")
 	c.Assert(PrettySize(float32(3000125)), Equals, "2.86MB")
 	c.Assert(PrettySize(float64(3000125)), Equals, "2.86MB")
+	c.Assert(PrettySize(float64(345)), Equals, "345B")
+	c.Assert(PrettySize(uint64(345)), Equals, "345B")
+	c.Assert(PrettySize(-345), Equals, "-345B")
+	c.Assert(PrettySize(-2048), Equals, "-2KB")
+	c.Assert(PrettySize(-3000125), Equals, "-2.86MB")
+	c.Assert(PrettySize(float64(1<<60)), Equals, "1048576TB")
+}
+
+func (s *FmtUtilSuite) TestPrettyPerc(c *C) {
+	c.Assert(PrettyPerc(0), Equals, "0%")
+	c.Assert(PrettyPerc(12.3456), Equals, "12.3%")
+	c.Assert(PrettyPerc(5.678), Equals, "5.68%")
+	c.Assert(PrettyPerc(100), Equals, "100%")
+	c.Assert(PrettyPerc(1e7), Equals, "10000000%")
+	c.Assert(PrettyPerc(-12.3456), Equals, "-12.3%")
 }
 
 func (s *FmtUtilSuite) TestParseSize(c *C) {
@@ -73,6 +91,7 @@ func (s *FmtUtilSuite) TestFloat(c *C) {
 	c.Assert(Float(0.01), Equals, 0.01)
 	c.Assert(Float(0.001), Equals, 0.0)
 	c.Assert(Float(0.0001), Equals, 0.0)
+	c.Assert(Float(-12.345), Equals, -12.3)
 }
 
 func (s *FmtUtilSuite) TestWrap(c *C) {
@@ -121,6 +140,40 @@ consequatur, vel illum qui dolorem eum fugiat quo voluptas nulla pariatur?
 	c.Assert(Wrap(input, "  ", 40), Equals, result)
 }
 
+func (s *FmtUtilSuite) TestColoredWrap(c *C) {
+	c.Assert(Wrap("{r}Test{!} {g}text{!} {b}wrapping{!}", "", 10), Equals, "{r}Test{!} {g}text{!} \n{b}wrapping{!}")
+	c.Assert(Wrap("\x1b[31mTest\x1b[0m text wrapping", "", 10), Equals, "\x1b[31mTest\x1b[0m text \nwrapping")
+	c.Assert(Wrap("Тест тест тест", "", 10), Equals, "Тест тест \nтест")
+}
+
+func (s *FmtUtilSuite) TestPadding(c *C) {
+	c.Assert(PadLeft("Test", 6), Equals, "  Test")
+	c.Assert(PadLeft("{r}Test{!}", 6), Equals, "  {r}Test{!}")
+	c.Assert(PadLeft("Test", 2), Equals, "Test")
+	c.Assert(PadRight("Test", 6), Equals, "Test  ")
+	c.Assert(PadRight("\x1b[31mTest\x1b[0m", 6), Equals, "\x1b[31mTest\x1b[0m  ")
+	c.Assert(PadRight("Тест", 6), Equals, "Тест  ")
+	c.Assert(PadRight("Test", 4), Equals, "Test")
+	c.Assert(Center("Test", 8), Equals, "  Test  ")
+	c.Assert(Center("{g}Test{!}", 9), Equals, "  {g}Test{!}   ")
+	c.Assert(Center("Test", 3), Equals, "Test")
+	c.Assert(PadLeft("日本", 6), Equals, "  日本")
+	c.Assert(PadRight("{y}日本{!}", 6), Equals, "{y}日本{!}  ")
+}
+
+func (s *FmtUtilSuite) TestWidth(c *C) {
+	c.Assert(Strip("{r*}Error:{!} \x1b[1mtest\x1b[0m \x1b]0;title\x07"), Equals, "Error: test ")
+	c.Assert(Width(""), Equals, 0)
+	c.Assert(Width("{g}Test{!}"), Equals, 4)
+	c.Assert(Width("Тест"), Equals, 4)
+	c.Assert(Width("日本語"), Equals, 6)
+	c.Assert(Width("e\u0301"), Equals, 1)
+	c.Assert(RuneWidth('\t'), Equals, 0)
+	c.Assert(RuneWidth('Ы'), Equals, 1)
+	c.Assert(RuneWidth('界'), Equals, 2)
+	c.Assert(Wrap("日本語 日本語", "", 8), Equals, "日本語 \n日本語")
+}
+
 func (s *FmtUtilSuite) TestSeparator(c *C) {
 	SeparatorSize = 1
 
@@ -133,6 +186,13 @@ func (s *FmtUtilSuite) TestSeparator(c *C) {
 	SeparatorFullscreen = true
 
 	Separator(true)
+	Header("test")
+
+	SeparatorFullscreen = false
+
+	c.Assert(RenderSeparator(""), Equals, "{s}"+strings.Repeat("-", 80)+"{!}")
+	c.Assert(RenderSeparator("{g}test{!}"), Equals, "{s}--{!} {s}{g}test{!}{!} {s}"+strings.Repeat("-", 72)+"{!}")
+	c.Assert(RenderHeader("TEST"), Equals, "{s}=={!} {*}TEST{!} {s}"+strings.Repeat("=", 72)+"{!}")
 
 	c.Assert(between(0, 1, 3), Equals, 1)
 	c.Assert(between(2, 1, 3), Equals, 2)
@@ -146,6 +206,17 @@ func (s *FmtUtilSuite) TestCountDigits(c *C) {
 	c.Assert(CountDigits(-45999), Equals, 6)
 }
 
+func (s *FmtUtilSuite) TestColorizeByValue(c *C) {
+	thresholds := []Threshold{{0, "{g}"}, {90, "{r}"}, {70, "{y}"}}
+
+	c.Assert(ColorizeByValue(15.5, thresholds), Equals, "{g}")
+	c.Assert(ColorizeByValue(70, thresholds), Equals, "{y}")
+	c.Assert(ColorizeByValue(89.99, thresholds), Equals, "{y}")
+	c.Assert(ColorizeByValue(100, thresholds), Equals, "{r}")
+	c.Assert(ColorizeByValue(-1, thresholds), Equals, "")
+	c.Assert(ColorizeByValue(50, nil), Equals, "")
+}
+
 func (s *FmtUtilSuite) TestColorizePassword(c *C) {
 	p1 := "acbdabcd"
 	p2 := "ABcd12AB"
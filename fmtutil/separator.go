@@ -22,6 +22,9 @@ var SeparatorColorTag string = "{s}"
 // SeparatorTitleColorTag is fmtc color tag used for separator title (light grey by default)
 var SeparatorTitleColorTag = "{s}"
 
+// HeaderColorTag is fmtc color tag used for header title (bold by default)
+var HeaderColorTag = "{*}"
+
 // SeparatorFullscreen allow to enable full screen separator
 var SeparatorFullscreen = false
 
@@ -33,22 +36,11 @@ var SeparatorSize = 88
 // Separator print separator to output
 func Separator(tiny bool, args ...string) {
 	var separator string
-	var size int
-
-	if SeparatorFullscreen {
-		size = between(window.GetWidth(), 16, 999999)
-	} else {
-		size = between(SeparatorSize, 80, 999999)
-	}
 
 	if len(args) != 0 {
-		name := args[0]
-		sep := getSeparator(size)
-		rem := between((len(sep)-4)-len(name), 0, 999999)
-		separator = SeparatorColorTag + "--{!} " + SeparatorTitleColorTag + name + "{!} "
-		separator += SeparatorColorTag + sep[:rem] + "{!}"
+		separator = RenderSeparator(args[0])
 	} else {
-		separator = SeparatorColorTag + getSeparator(size) + "{!}"
+		separator = RenderSeparator("")
 	}
 
 	if !tiny {
@@ -58,10 +50,47 @@ func Separator(tiny bool, args ...string) {
 	fmtc.Println(separator)
 }
 
+// Header print section header to output
+func Header(title string) {
+	fmtc.Println("\n" + RenderHeader(title) + "\n")
+}
+
+// RenderSeparator return separator with given title (title can be empty)
+// as string with fmtc color tags
+func RenderSeparator(title string) string {
+	return renderLine("-", SeparatorTitleColorTag, title)
+}
+
+// RenderHeader return section header with given title as string with
+// fmtc color tags
+func RenderHeader(title string) string {
+	return renderLine("=", HeaderColorTag, title)
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-func getSeparator(size int) string {
-	return strings.Repeat("-", size)
+// renderLine render line with given symbol and title
+func renderLine(symbol, titleColorTag, title string) string {
+	size := getSeparatorSize()
+
+	if title == "" {
+		return SeparatorColorTag + strings.Repeat(symbol, size) + "{!}"
+	}
+
+	rem := between((size-4)-Width(title), 0, 999999)
+
+	return SeparatorColorTag + strings.Repeat(symbol, 2) + "{!} " +
+		titleColorTag + title + "{!} " +
+		SeparatorColorTag + strings.Repeat(symbol, rem) + "{!}"
+}
+
+// getSeparatorSize return separator size
+func getSeparatorSize() int {
+	if SeparatorFullscreen {
+		return between(window.GetWidth(), 16, 999999)
+	}
+
+	return between(SeparatorSize, 80, 999999)
 }
 
 func between(val, min, max int) int {
@@ -0,0 +1,45 @@
+package table
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func ExampleNewTable() {
+	t := NewTable()
+
+	t.Borders = true
+
+	t.AddColumn("Name", 0, ALIGN_LEFT, "")
+	t.AddColumn("Size", 10, ALIGN_RIGHT, "{g}")
+
+	t.Add("file.txt", "1.2MB")
+	t.Add("archive.tar.gz", "34.1MB")
+
+	// Print table to standard output
+	t.Render()
+}
+
+func ExampleTable_RenderTo() {
+	t := NewTable()
+
+	// Set table width explicitly instead of using terminal window width
+	t.Width = 60
+
+	t.AddColumn("ID", 4, ALIGN_RIGHT, "")
+	t.AddColumn("Status", 0, ALIGN_LEFT, "")
+
+	t.Add(1, "{g}ok{!}")
+	t.Add(2, "{r}error{!}")
+
+	// Write table to any writer
+	t.RenderTo(os.Stderr)
+}
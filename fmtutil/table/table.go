@@ -0,0 +1,357 @@
+// Package table provides methods for rendering data as a table
+package table
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+	"pkg.re/essentialkaos/ek.v7/fmtutil"
+	"pkg.re/essentialkaos/ek.v7/terminal/window"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Column alignment
+const (
+	ALIGN_LEFT   = 0
+	ALIGN_CENTER = 1
+	ALIGN_RIGHT  = 2
+)
+
+// _MIN_COLUMN_WIDTH is minimal width of column with automatic width
+const _MIN_COLUMN_WIDTH = 3
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Table contains table columns and rows
+type Table struct {
+	// Width is max table width, if 0 terminal window width is used
+	Width int
+
+	// Borders enable borders around table and between columns
+	Borders bool
+
+	// Separators enable separators between rows
+	Separators bool
+
+	// HeaderColorTag is fmtc color tag used for column titles
+	HeaderColorTag string
+
+	// BorderColorTag is fmtc color tag used for borders and separators
+	BorderColorTag string
+
+	columns []*Column
+	rows    [][]string
+}
+
+// Column contains column info
+type Column struct {
+	Title    string // Column title
+	Width    int    // Column width, if 0 column width is calculated automatically
+	Align    int    // Column alignment
+	ColorTag string // fmtc color tag used for column cells
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DefaultWidth is table width used if terminal window width can't be detected
+var DefaultWidth = 88
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// NewTable create new table struct
+func NewTable() *Table {
+	return &Table{
+		HeaderColorTag: "{*}",
+		BorderColorTag: "{s}",
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// AddColumn add new column to table
+func (t *Table) AddColumn(title string, width, align int, colorTag string) *Table {
+	t.columns = append(t.columns, &Column{
+		Title:    title,
+		Width:    width,
+		Align:    align,
+		ColorTag: colorTag,
+	})
+
+	return t
+}
+
+// Add add new row to table
+func (t *Table) Add(data ...interface{}) *Table {
+	row := make([]string, len(data))
+
+	for index, value := range data {
+		row[index] = fmt.Sprint(value)
+	}
+
+	t.rows = append(t.rows, row)
+
+	return t
+}
+
+// Columns return table columns
+func (t *Table) Columns() []*Column {
+	return t.columns
+}
+
+// Len return number of rows in table
+func (t *Table) Len() int {
+	return len(t.rows)
+}
+
+// Clear remove all rows from table
+func (t *Table) Clear() *Table {
+	t.rows = nil
+	return t
+}
+
+// Render print table to standard output
+func (t *Table) Render() error {
+	return t.RenderTo(os.Stdout)
+}
+
+// RenderTo write table to given writer
+func (t *Table) RenderTo(w io.Writer) error {
+	if len(t.columns) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+
+	widths := t.calculateWidths()
+	separator := t.BorderColorTag + t.getSeparator(widths) + "{!}"
+
+	if t.Borders {
+		buf.WriteString(separator + "\n")
+	}
+
+	if t.hasHeader() {
+		titles := make([]string, len(t.columns))
+
+		for index, column := range t.columns {
+			titles[index] = column.Title
+		}
+
+		buf.WriteString(t.formatRow(titles, widths, true) + "\n")
+		buf.WriteString(separator + "\n")
+	}
+
+	for index, row := range t.rows {
+		if index != 0 && t.Separators {
+			buf.WriteString(separator + "\n")
+		}
+
+		buf.WriteString(t.formatRow(row, widths, false) + "\n")
+	}
+
+	if t.Borders && (len(t.rows) != 0 || !t.hasHeader()) {
+		buf.WriteString(separator + "\n")
+	}
+
+	_, err := fmtc.Fprint(w, buf.String())
+
+	return err
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// hasHeader return true if at least one column has title
+func (t *Table) hasHeader() bool {
+	for _, column := range t.columns {
+		if column.Title != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// calculateWidths calculate width of every column
+func (t *Table) calculateWidths() []int {
+	widths := make([]int, len(t.columns))
+
+	var fixed, auto []int
+
+	for index, column := range t.columns {
+		if column.Width > 0 {
+			widths[index] = column.Width
+			fixed = append(fixed, index)
+			continue
+		}
+
+		widths[index] = fmtutil.Width(column.Title)
+
+		for _, row := range t.rows {
+			if index < len(row) {
+				widths[index] = max(widths[index], fmtutil.Width(row[index]))
+			}
+		}
+
+		widths[index] = max(widths[index], _MIN_COLUMN_WIDTH)
+		auto = append(auto, index)
+	}
+
+	if len(auto) == 0 {
+		return widths
+	}
+
+	maxWidth := t.getMaxWidth() - t.getDecorationsSize()
+
+	for _, index := range fixed {
+		maxWidth -= widths[index]
+	}
+
+	// Shrink widest columns with automatic width until table
+	// fits max width
+	for {
+		total, widest := 0, auto[0]
+
+		for _, index := range auto {
+			total += widths[index]
+
+			if widths[index] > widths[widest] {
+				widest = index
+			}
+		}
+
+		if total <= maxWidth || widths[widest] <= _MIN_COLUMN_WIDTH {
+			break
+		}
+
+		widths[widest]--
+	}
+
+	return widths
+}
+
+// getMaxWidth return max table width
+func (t *Table) getMaxWidth() int {
+	if t.Width > 0 {
+		return t.Width
+	}
+
+	width := window.GetWidth()
+
+	if width <= 0 {
+		return DefaultWidth
+	}
+
+	return width
+}
+
+// getDecorationsSize return number of symbols used for borders and
+// spaces between columns
+func (t *Table) getDecorationsSize() int {
+	if t.Borders {
+		return len(t.columns)*3 + 1
+	}
+
+	return (len(t.columns) - 1) * 2
+}
+
+// getSeparator return separator line
+func (t *Table) getSeparator(widths []int) string {
+	lines := make([]string, len(widths))
+
+	for index, width := range widths {
+		lines[index] = strings.Repeat("-", width)
+	}
+
+	if t.Borders {
+		return "+-" + strings.Join(lines, "-+-") + "-+"
+	}
+
+	return strings.Join(lines, "--")
+}
+
+// formatRow format row cells
+func (t *Table) formatRow(row []string, widths []int, isHeader bool) string {
+	cells := make([]string, len(t.columns))
+
+	for index, column := range t.columns {
+		var cell string
+
+		if index < len(row) {
+			cell = row[index]
+		}
+
+		if fmtutil.Width(cell) > widths[index] {
+			cell = truncate(fmtutil.Strip(cell), widths[index])
+		}
+
+		colorTag := column.ColorTag
+
+		if isHeader {
+			colorTag = t.HeaderColorTag
+		}
+
+		if colorTag != "" {
+			cell = colorTag + cell + "{!}"
+		}
+
+		switch column.Align {
+		case ALIGN_RIGHT:
+			cell = fmtutil.PadLeft(cell, widths[index])
+		case ALIGN_CENTER:
+			cell = fmtutil.Center(cell, widths[index])
+		default:
+			cell = fmtutil.PadRight(cell, widths[index])
+		}
+
+		cells[index] = cell
+	}
+
+	if t.Borders {
+		border := t.BorderColorTag + "|{!}"
+		return border + " " + strings.Join(cells, " "+border+" ") + " " + border
+	}
+
+	return strings.TrimRight(strings.Join(cells, "  "), " ")
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// truncate cut string to given number of terminal cells
+func truncate(s string, size int) string {
+	limit, suffix := size, ""
+
+	if size > 1 {
+		limit, suffix = size-1, "…"
+	}
+
+	var width int
+
+	for index, r := range s {
+		width += fmtutil.RuneWidth(r)
+
+		if width > limit {
+			return s[:index] + suffix
+		}
+	}
+
+	return s
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
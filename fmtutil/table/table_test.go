@@ -0,0 +1,134 @@
+package table
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"testing"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+
+	. "pkg.re/check.v1"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TableSuite struct{}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var _ = Suite(&TableSuite{})
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func (s *TableSuite) SetUpSuite(c *C) {
+	fmtc.DisableColors = true
+}
+
+func (s *TableSuite) TestBasic(c *C) {
+	t := NewTable()
+	t.Width = 40
+
+	t.AddColumn("NAME", 0, ALIGN_LEFT, "")
+	t.AddColumn("SIZE", 0, ALIGN_RIGHT, "{g}")
+
+	t.Add("file.txt", 100)
+	t.Add("{r}archive.tar.gz{!}", 34000)
+
+	c.Assert(t.Len(), Equals, 2)
+	c.Assert(t.Columns(), HasLen, 2)
+
+	buf := &bytes.Buffer{}
+
+	c.Assert(t.RenderTo(buf), IsNil)
+	c.Assert(buf.String(), Equals,
+		"NAME             SIZE\n"+
+			"---------------------\n"+
+			"file.txt          100\n"+
+			"archive.tar.gz  34000\n",
+	)
+
+	t.Clear()
+
+	c.Assert(t.Len(), Equals, 0)
+}
+
+func (s *TableSuite) TestBorders(c *C) {
+	t := NewTable()
+	t.Width = 40
+	t.Borders = true
+	t.Separators = true
+
+	t.AddColumn("ID", 0, ALIGN_CENTER, "")
+	t.AddColumn("STATUS", 8, ALIGN_LEFT, "")
+
+	t.Add(1, "ok")
+	t.Add(12, "error")
+
+	buf := &bytes.Buffer{}
+
+	c.Assert(t.RenderTo(buf), IsNil)
+	c.Assert(buf.String(), Equals,
+		"+-----+----------+\n"+
+			"| ID  | STATUS   |\n"+
+			"+-----+----------+\n"+
+			"|  1  | ok       |\n"+
+			"+-----+----------+\n"+
+			"| 12  | error    |\n"+
+			"+-----+----------+\n",
+	)
+}
+
+func (s *TableSuite) TestSizing(c *C) {
+	t := NewTable()
+	t.Width = 20
+
+	t.AddColumn("", 0, ALIGN_LEFT, "")
+	t.AddColumn("", 4, ALIGN_LEFT, "")
+
+	t.Add("Very long text in first column", "abcdefgh")
+	t.Add("Short", "abc")
+	t.Add("Тест")
+
+	buf := &bytes.Buffer{}
+
+	c.Assert(t.RenderTo(buf), IsNil)
+	c.Assert(buf.String(), Equals,
+		"Very long tex…  abc…\n"+
+			"Short           abc\n"+
+			"Тест\n",
+	)
+}
+
+func (s *TableSuite) TestWideSymbols(c *C) {
+	t := NewTable()
+	t.Width = 20
+
+	t.AddColumn("", 0, ALIGN_LEFT, "")
+	t.AddColumn("", 6, ALIGN_LEFT, "")
+
+	t.Add("日本語", "{g}日本語テキスト{!}")
+	t.Add("abc", "ok")
+
+	buf := &bytes.Buffer{}
+
+	c.Assert(t.RenderTo(buf), IsNil)
+	c.Assert(buf.String(), Equals,
+		"日本語  日本…\n"+
+			"abc     ok\n",
+	)
+}
+
+func (s *TableSuite) TestEmpty(c *C) {
+	buf := &bytes.Buffer{}
+
+	c.Assert(NewTable().RenderTo(buf), IsNil)
+	c.Assert(buf.String(), Equals, "")
+}
@@ -0,0 +1,83 @@
+package fmtutil
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"regexp"
+	"unicode"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ansiRegExp is regexp for CSI and OSC escape sequences
+var ansiRegExp = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(\x07|\x1b\\)`)
+
+// wideRanges contains ranges of wide (East Asian Wide and Fullwidth) symbols
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},
+	{0x2E80, 0x303E},
+	{0x3041, 0x33FF},
+	{0x3400, 0x4DBF},
+	{0x4E00, 0x9FFF},
+	{0xA000, 0xA4CF},
+	{0xAC00, 0xD7A3},
+	{0xF900, 0xFAFF},
+	{0xFE30, 0xFE4F},
+	{0xFF00, 0xFF60},
+	{0xFFE0, 0xFFE6},
+	{0x1F300, 0x1F64F},
+	{0x1F900, 0x1F9FF},
+	{0x20000, 0x2FFFD},
+	{0x30000, 0x3FFFD},
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Strip remove fmtc color tags and ANSI escape sequences from given string
+func Strip(s string) string {
+	return ansiRegExp.ReplaceAllString(fmtc.Clean(s), "")
+}
+
+// Width return number of terminal cells required for printing given string.
+// Color tags and ANSI escape sequences are ignored, wide symbols take two
+// cells, combining and control symbols doesn't take any space.
+func Width(s string) int {
+	var result int
+
+	for _, r := range Strip(s) {
+		result += RuneWidth(r)
+	}
+
+	return result
+}
+
+// RuneWidth return number of terminal cells required for printing given rune
+func RuneWidth(r rune) int {
+	switch {
+	case r < 0x20, r >= 0x7F && r < 0xA0:
+		return 0
+	case r < 0x300:
+		return 1
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	}
+
+	for _, wr := range wideRanges {
+		if r < wr[0] {
+			break
+		}
+
+		if r <= wr[1] {
+			return 2
+		}
+	}
+
+	return 1
+}
@@ -31,4 +31,25 @@ func ExampleFileHash() {
 	hash := FileHash("/path/to/some/file")
 
 	fmt.Println(hash)
+
+	// You can define hash algorithm
+	hash = FileHash("/path/to/some/file", MD5)
+
+	fmt.Println(hash)
+}
+
+func ExampleString() {
+	fmt.Println(String("test", SHA1))
+
+	// Output:
+	// a94a8fe5ccb19ba61c4c0873d391e987982fbbd3
+}
+
+func ExampleEqual() {
+	token := String("secret-token", SHA256)
+
+	fmt.Println(Equal(token, String("secret-token", SHA256)))
+
+	// Output:
+	// true
 }
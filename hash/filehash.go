@@ -9,7 +9,6 @@ package hash
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
-	"crypto/sha256"
 	"fmt"
 	"io"
 	"os"
@@ -17,8 +16,9 @@ import (
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// FileHash generate SHA-256 hash for file
-func FileHash(file string) string {
+// FileHash generate hash for file using given algorithm (SHA-256 is used
+// by default) and return it as hex string
+func FileHash(file string, algo ...Algorithm) string {
 	fd, err := os.OpenFile(file, os.O_RDONLY, 0644)
 
 	if err != nil {
@@ -27,9 +27,19 @@ func FileHash(file string) string {
 
 	defer fd.Close()
 
-	hasher := sha256.New()
+	a := SHA256
+
+	if len(algo) != 0 {
+		a = algo[0]
+	}
+
+	hasher := a.New()
+
+	if hasher == nil {
+		return ""
+	}
 
 	io.Copy(hasher, fd)
 
-	return fmt.Sprintf("%064x", hasher.Sum(nil))
+	return fmt.Sprintf("%x", hasher.Sum(nil))
 }
@@ -0,0 +1,125 @@
+package hash
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"hash/fnv"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Algorithm is hash algorithm
+type Algorithm uint8
+
+// Supported hash algorithms
+const (
+	MD5    Algorithm = 1
+	SHA1   Algorithm = 2
+	SHA256 Algorithm = 3
+	SHA512 Algorithm = 4
+	CRC32  Algorithm = 5 // CRC-32 (IEEE)
+	FNV32  Algorithm = 6 // FNV-1a 32-bit
+	FNV64  Algorithm = 7 // FNV-1a 64-bit
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// New create new hasher for algorithm (return nil for unknown algorithm)
+func (a Algorithm) New() hash.Hash {
+	switch a {
+	case MD5:
+		return md5.New()
+	case SHA1:
+		return sha1.New()
+	case SHA256:
+		return sha256.New()
+	case SHA512:
+		return sha512.New()
+	case CRC32:
+		return crc32.NewIEEE()
+	case FNV32:
+		return fnv.New32a()
+	case FNV64:
+		return fnv.New64a()
+	}
+
+	return nil
+}
+
+// String return algorithm name
+func (a Algorithm) String() string {
+	switch a {
+	case MD5:
+		return "md5"
+	case SHA1:
+		return "sha1"
+	case SHA256:
+		return "sha256"
+	case SHA512:
+		return "sha512"
+	case CRC32:
+		return "crc32"
+	case FNV32:
+		return "fnv32a"
+	case FNV64:
+		return "fnv64a"
+	}
+
+	return "unknown"
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Sum return raw hash of data (return nil for unknown algorithm)
+func Sum(data []byte, algo Algorithm) []byte {
+	hasher := algo.New()
+
+	if hasher == nil {
+		return nil
+	}
+
+	hasher.Write(data)
+
+	return hasher.Sum(nil)
+}
+
+// Bytes return hash of data as hex string
+func Bytes(data []byte, algo Algorithm) string {
+	return hex.EncodeToString(Sum(data, algo))
+}
+
+// String return hash of string as hex string
+func String(data string, algo Algorithm) string {
+	return Bytes([]byte(data), algo)
+}
+
+// Base64 return hash of data as base64 string
+func Base64(data []byte, algo Algorithm) string {
+	sum := Sum(data, algo)
+
+	if sum == nil {
+		return ""
+	}
+
+	return base64.StdEncoding.EncodeToString(sum)
+}
+
+// Equal compare two hashes in constant time (useful for comparing
+// hashes of secrets, like tokens or passwords)
+func Equal(h1, h2 string) bool {
+	return subtle.ConstantTimeCompare([]byte(h1), []byte(h2)) == 1
+}
@@ -57,4 +57,32 @@ func (s *HashSuite) TestFileHash(c *C) {
 
 	c.Assert(hash1, Equals, "2d7ec20906125cd23fee7b628b98463d554b1105b141b2d39a19bac5f3274dec")
 	c.Assert(hash2, Equals, "")
+
+	c.Assert(FileHash(tempFile, SHA256), Equals, hash1)
+	c.Assert(FileHash(tempFile, MD5), Equals, String("ABCDEF12345\n\n", MD5))
+	c.Assert(FileHash(tempFile, Algorithm(0)), Equals, "")
+}
+
+func (s *HashSuite) TestHash(c *C) {
+	c.Assert(String("test", MD5), Equals, "098f6bcd4621d373cade4e832627b4f6")
+	c.Assert(String("test", SHA1), Equals, "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3")
+	c.Assert(String("test", SHA256), Equals, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	c.Assert(String("test", SHA512), Equals, "ee26b0dd4af7e749aa1a8ee3c10ae9923f618980772e473f8819a5d4940e0db27ac185f8a0e1d5f84f88bc887fd67b143732c304cc5fa9ad8e6f57f50028a8ff")
+	c.Assert(String("test", CRC32), Equals, "d87f7e0c")
+	c.Assert(String("test", FNV32), Equals, "afd071e5")
+	c.Assert(String("test", FNV64), Equals, "f9e6e6ef197c2b25")
+	c.Assert(String("test", Algorithm(0)), Equals, "")
+
+	c.Assert(Bytes([]byte("test"), MD5), Equals, "098f6bcd4621d373cade4e832627b4f6")
+	c.Assert(Base64([]byte("test"), MD5), Equals, "CY9rzUYh03PK3k6DJie09g==")
+	c.Assert(Base64([]byte("test"), Algorithm(0)), Equals, "")
+	c.Assert(Sum([]byte("test"), CRC32), DeepEquals, []byte{0xd8, 0x7f, 0x7e, 0x0c})
+
+	c.Assert(SHA256.String(), Equals, "sha256")
+	c.Assert(FNV64.String(), Equals, "fnv64a")
+	c.Assert(Algorithm(0).String(), Equals, "unknown")
+
+	c.Assert(Equal("abcd", "abcd"), Equals, true)
+	c.Assert(Equal("abcd", "abce"), Equals, false)
+	c.Assert(Equal("abcd", "abc"), Equals, false)
 }
@@ -8,9 +8,15 @@ package httputil
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"errors"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"pkg.re/essentialkaos/ek.v7/log"
+
 	. "pkg.re/check.v1"
 )
 
@@ -121,3 +127,106 @@ func (s *HTTPUtilSuite) TestURLCheck(c *C) {
 	c.Assert(IsURL("https://domain.com:8080"), Equals, true)
 	c.Assert(IsURL("http://domain.com:8080/my/super/method?id=123#TEST"), Equals, true)
 }
+
+func (s *HTTPUtilSuite) TestWriteJSON(c *C) {
+	w := httptest.NewRecorder()
+
+	c.Assert(WriteJSON(w, 201, map[string]int{"id": 1}), IsNil)
+	c.Assert(w.Code, Equals, 201)
+	c.Assert(w.Header().Get("Content-Type"), Equals, "application/json; charset=utf-8")
+	c.Assert(w.Body.String(), Equals, "{\"id\":1}\n")
+
+	w = httptest.NewRecorder()
+
+	c.Assert(WriteError(w, 404, "Not found"), IsNil)
+	c.Assert(w.Code, Equals, 404)
+	c.Assert(w.Body.String(), Equals, "{\"error\":\"Not found\"}\n")
+}
+
+func (s *HTTPUtilSuite) TestHealthHandlers(c *C) {
+	var ready error
+
+	mux := http.NewServeMux()
+	RegisterHealthHandlers(mux, func() error { return nil }, func() error { return ready })
+
+	req, _ := http.NewRequest("GET", "http://127.0.0.1/health", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	c.Assert(w.Code, Equals, 200)
+	c.Assert(w.Body.String(), Equals, "{\"status\":\"ok\"}\n")
+
+	req, _ = http.NewRequest("GET", "http://127.0.0.1/ready", nil)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	c.Assert(w.Code, Equals, 200)
+
+	ready = errors.New("DB is not available")
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	c.Assert(w.Code, Equals, 503)
+	c.Assert(w.Body.String(), Equals, "{\"status\":\"fail\",\"error\":\"DB is not available\"}\n")
+}
+
+func (s *HTTPUtilSuite) TestLogRequests(c *C) {
+	logFile := c.MkDir() + "/test.log"
+	logger, err := log.New(logFile, 0644)
+
+	c.Assert(err, IsNil)
+
+	handler := LogRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.Write([]byte("Hello"))
+		default:
+			WriteError(w, 500, "Error")
+		}
+	}), logger)
+
+	req, _ := http.NewRequest("GET", "http://127.0.0.1/ok?id=1", nil)
+	req.RemoteAddr = "192.168.1.1:43210"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	req, _ = http.NewRequest("POST", "http://127.0.0.1/fail", nil)
+	req.RemoteAddr = "192.168.1.1:43210"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	data, err := ioutil.ReadFile(logFile)
+
+	c.Assert(err, IsNil)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+
+	c.Assert(lines, HasLen, 2)
+	c.Assert(strings.Contains(lines[0], " 192.168.1.1 GET /ok?id=1 200 5 "), Equals, true)
+	c.Assert(strings.Contains(lines[1], "[ERROR] 192.168.1.1 POST /fail 500 18 "), Equals, true)
+}
+
+func (s *HTTPUtilSuite) TestStatusWriterPassthrough(c *C) {
+	rec := httptest.NewRecorder()
+	sw := &statusWriter{ResponseWriter: rec}
+
+	var w http.ResponseWriter = sw
+
+	flusher, ok := w.(http.Flusher)
+
+	c.Assert(ok, Equals, true)
+
+	flusher.Flush()
+
+	c.Assert(rec.Flushed, Equals, true)
+	c.Assert(sw.status, Equals, http.StatusOK)
+
+	hijacker, ok := w.(http.Hijacker)
+
+	c.Assert(ok, Equals, true)
+
+	conn, rw, err := hijacker.Hijack()
+
+	c.Assert(conn, IsNil)
+	c.Assert(rw, IsNil)
+	c.Assert(err, Equals, ErrHijackNotSupported)
+}
@@ -0,0 +1,188 @@
+package httputil
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"pkg.re/essentialkaos/ek.v7/log"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Default paths for health and readiness endpoints
+const (
+	HEALTH_PATH    = "/health"
+	READINESS_PATH = "/ready"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrHijackNotSupported is returned if underlying response writer doesn't
+// support hijacking
+var ErrHijackNotSupported = errors.New("Response writer doesn't support hijacking")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Check is readiness check function
+type Check func() error
+
+// statusWriter is response writer which keeps response status and size
+type statusWriter struct {
+	http.ResponseWriter
+
+	status int
+	size   int
+}
+
+// statusInfo contains health or readiness status
+type statusInfo struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// errorInfo contains error message
+type errorInfo struct {
+	Error string `json:"error"`
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// WriteJSON encode data to JSON and write it to response with given status code
+func WriteJSON(w http.ResponseWriter, status int, data interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	return json.NewEncoder(w).Encode(data)
+}
+
+// WriteError write JSON with error message to response with given status code
+func WriteError(w http.ResponseWriter, status int, message string) error {
+	return WriteJSON(w, status, errorInfo{message})
+}
+
+// HealthHandler return handler which always respond with status 200 while
+// server is alive
+func HealthHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, http.StatusOK, statusInfo{Status: "ok"})
+	}
+}
+
+// ReadinessHandler return handler which respond with status 200 if all given
+// checks passed and with status 503 if any check return error
+func ReadinessHandler(checks ...Check) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, check := range checks {
+			err := check()
+
+			if err != nil {
+				WriteJSON(w, http.StatusServiceUnavailable, statusInfo{"fail", err.Error()})
+				return
+			}
+		}
+
+		WriteJSON(w, http.StatusOK, statusInfo{Status: "ok"})
+	}
+}
+
+// RegisterHealthHandlers register health and readiness handlers with
+// default paths
+func RegisterHealthHandlers(mux *http.ServeMux, checks ...Check) {
+	mux.Handle(HEALTH_PATH, HealthHandler())
+	mux.Handle(READINESS_PATH, ReadinessHandler(checks...))
+}
+
+// LogRequests return handler which write info about every request to given
+// logger (global logger is used if logger is nil). Requests with 4xx status
+// are logged as warnings, with 5xx status as errors.
+func LogRequests(handler http.Handler, logger *log.Logger) http.Handler {
+	if logger == nil {
+		logger = log.Global
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w}
+
+		handler.ServeHTTP(sw, r)
+
+		if sw.status == 0 {
+			sw.status = http.StatusOK
+		}
+
+		level := log.INFO
+
+		switch {
+		case sw.status >= 500:
+			level = log.ERROR
+		case sw.status >= 400:
+			level = log.WARN
+		}
+
+		logger.Print(
+			level, "%s %s %s %d %d %v", GetRemoteHost(r), r.Method,
+			r.URL.RequestURI(), sw.status, sw.size, time.Since(start),
+		)
+	})
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// WriteHeader save status code and send it to client
+func (w *statusWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write save size of written data and send data to client
+func (w *statusWriter) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	n, err := w.ResponseWriter.Write(data)
+	w.size += n
+
+	return n, err
+}
+
+// Flush send buffered data to client if underlying response writer
+// supports flushing
+func (w *statusWriter) Flush() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack take over connection if underlying response writer supports it
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+
+	if !ok {
+		return nil, nil, ErrHijackNotSupported
+	}
+
+	if w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+
+	return h.Hijack()
+}
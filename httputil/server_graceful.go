@@ -0,0 +1,50 @@
+// +build go1.8
+
+package httputil
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ListenAndServeGraceful start server and wait until given context is done or
+// TERM or INT signal is received, after that server stops accepting new
+// connections and waits (no longer than given timeout) while active requests
+// are completed. This method return nil if server was stopped by context or
+// signal.
+func ListenAndServeGraceful(ctx context.Context, srv *http.Server, timeout time.Duration) error {
+	signals := make(chan os.Signal, 1)
+	errs := make(chan error, 1)
+
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	defer signal.Stop(signals)
+
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	case <-signals:
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return srv.Shutdown(shutdownCtx)
+}
@@ -0,0 +1,35 @@
+// +build go1.8
+
+package httputil
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "pkg.re/check.v1"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func (s *HTTPUtilSuite) TestListenAndServeGracefulContext(c *C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := &http.Server{Addr: "127.0.0.1:0"}
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	c.Assert(ListenAndServeGraceful(ctx, srv, time.Second), IsNil)
+}
+
+func (s *HTTPUtilSuite) TestListenAndServeGracefulError(c *C) {
+	srv := &http.Server{Addr: "127.0.0.1:-1"}
+
+	c.Assert(ListenAndServeGraceful(context.Background(), srv, time.Second), NotNil)
+}
@@ -0,0 +1,59 @@
+// +build linux
+
+package initsystem
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func ExampleCurrent() {
+	fmt.Printf("Init system: %s\n", Current())
+}
+
+func ExampleIsWorks() {
+	if !IsPresent("nginx") {
+		fmt.Println("Service nginx is not installed")
+		return
+	}
+
+	works, err := IsWorks("nginx")
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	enabled, _ := IsEnabled("nginx")
+
+	fmt.Printf("Works: %t, Enabled: %t\n", works, enabled)
+}
+
+func ExampleRestart() {
+	// In dry-run mode commands are not executed, so you can
+	// print them instead
+	DryRun = true
+
+	cmd, err := Command(ACTION_RESTART, "nginx")
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Command: %v\n", cmd)
+
+	err = Restart("nginx")
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
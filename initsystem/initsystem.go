@@ -0,0 +1,345 @@
+// +build linux
+
+// Package initsystem provides methods for working with different init systems
+package initsystem
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"pkg.re/essentialkaos/ek.v7/fsutil"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Init system names
+const (
+	SYSV    = "sysv"
+	UPSTART = "upstart"
+	SYSTEMD = "systemd"
+)
+
+// Service actions
+const (
+	ACTION_START   = "start"
+	ACTION_STOP    = "stop"
+	ACTION_RESTART = "restart"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DryRun is dry-run mode flag, if set to true Start, Stop and Restart
+// don't execute any commands
+var DryRun = false
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrNoInitSystem is returned if supported init system can't be found
+var ErrNoInitSystem = errors.New("Can't find supported init system")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Paths used for init system detection and searching services
+var (
+	systemdRunDir   = "/run/systemd/system"
+	systemdUnitDirs = []string{"/etc/systemd/system", "/run/systemd/system", "/usr/lib/systemd/system", "/lib/systemd/system"}
+	upstartConfDir  = "/etc/init"
+	upstartBin      = "/sbin/initctl"
+	sysvInitDirs    = []string{"/etc/rc.d/init.d", "/etc/init.d"}
+	sysvRcDirs      = "/etc/rc[2-5].d"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Systemd return true if systemd is used as init system
+func Systemd() bool {
+	return fsutil.IsDir(systemdRunDir)
+}
+
+// Upstart return true if upstart is used as init system
+func Upstart() bool {
+	return !Systemd() && fsutil.IsExecutable(upstartBin) && fsutil.IsDir(upstartConfDir)
+}
+
+// SysV return true if SysV init scripts are supported by system
+func SysV() bool {
+	return getSysVInitDir() != ""
+}
+
+// Current return name of current init system (systemd, upstart or sysv)
+// or empty string if supported init system can't be found
+func Current() string {
+	switch {
+	case Systemd():
+		return SYSTEMD
+	case Upstart():
+		return UPSTART
+	case SysV():
+		return SYSV
+	}
+
+	return ""
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsPresent return true if service with given name is present in system
+func IsPresent(name string) bool {
+	switch Current() {
+	case SYSTEMD:
+		if getSystemdUnitFile(name) != "" {
+			return true
+		}
+
+		// Systemd can manage SysV services
+		return getSysVScript(name) != ""
+	case UPSTART:
+		return fsutil.IsExist(getUpstartConfFile(name)) || getSysVScript(name) != ""
+	case SYSV:
+		return getSysVScript(name) != ""
+	}
+
+	return false
+}
+
+// IsWorks return true if service with given name is works
+func IsWorks(name string) (bool, error) {
+	switch Current() {
+	case SYSTEMD:
+		output, err := exec.Command("systemctl", "show", name, "-p", "ActiveState").Output()
+
+		if err != nil {
+			return false, fmt.Errorf("Can't get status of service %s: %v", name, err)
+		}
+
+		return strings.TrimSpace(string(output)) == "ActiveState=active", nil
+	case UPSTART:
+		if fsutil.IsExist(getUpstartConfFile(name)) {
+			output, err := exec.Command(upstartBin, "status", name).Output()
+
+			if err != nil {
+				return false, fmt.Errorf("Can't get status of service %s: %v", name, err)
+			}
+
+			return strings.Contains(string(output), "start/running"), nil
+		}
+
+		fallthrough
+	case SYSV:
+		script := getSysVScript(name)
+
+		if script == "" {
+			return false, fmt.Errorf("Service %s is not present", name)
+		}
+
+		// LSB: exit code 0 means that service is running
+		return exec.Command(script, "status").Run() == nil, nil
+	}
+
+	return false, ErrNoInitSystem
+}
+
+// IsEnabled return true if auto start is enabled for service with given name
+func IsEnabled(name string) (bool, error) {
+	switch Current() {
+	case SYSTEMD:
+		output, _ := exec.Command("systemctl", "is-enabled", name).Output()
+		status := strings.TrimSpace(string(output))
+
+		if status == "" {
+			return false, fmt.Errorf("Can't get status of service %s", name)
+		}
+
+		return status == "enabled", nil
+	case UPSTART:
+		if fsutil.IsExist(getUpstartConfFile(name)) {
+			return isUpstartEnabled(name)
+		}
+
+		fallthrough
+	case SYSV:
+		if getSysVScript(name) == "" {
+			return false, fmt.Errorf("Service %s is not present", name)
+		}
+
+		links, _ := filepath.Glob(sysvRcDirs + "/S[0-9][0-9]" + name)
+
+		return len(links) != 0, nil
+	}
+
+	return false, ErrNoInitSystem
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Start start service with given name
+func Start(name string) error {
+	return runAction(ACTION_START, name)
+}
+
+// Stop stop service with given name
+func Stop(name string) error {
+	return runAction(ACTION_STOP, name)
+}
+
+// Restart restart service with given name
+func Restart(name string) error {
+	return runAction(ACTION_RESTART, name)
+}
+
+// Command return command which will be executed for performing given
+// action (start, stop or restart) with service
+func Command(action, name string) ([]string, error) {
+	switch action {
+	case ACTION_START, ACTION_STOP, ACTION_RESTART:
+	default:
+		return nil, fmt.Errorf("Unknown action \"%s\"", action)
+	}
+
+	if name == "" {
+		return nil, errors.New("Service name can't be blank")
+	}
+
+	switch Current() {
+	case SYSTEMD:
+		return []string{"systemctl", action, name}, nil
+	case UPSTART:
+		if fsutil.IsExist(getUpstartConfFile(name)) {
+			return []string{upstartBin, action, name}, nil
+		}
+
+		fallthrough
+	case SYSV:
+		script := getSysVScript(name)
+
+		if script == "" {
+			return nil, fmt.Errorf("Service %s is not present", name)
+		}
+
+		return []string{script, action}, nil
+	}
+
+	return nil, ErrNoInitSystem
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// runAction execute command for given action
+func runAction(action, name string) error {
+	cmd, err := Command(action, name)
+
+	if err != nil {
+		return err
+	}
+
+	if DryRun {
+		return nil
+	}
+
+	output, err := exec.Command(cmd[0], cmd[1:]...).CombinedOutput()
+
+	if err != nil {
+		return fmt.Errorf("Can't %s service %s: %s", action, name, getErrorMessage(output, err))
+	}
+
+	return nil
+}
+
+// isUpstartEnabled return true if upstart job has start condition
+// and not disabled by override file
+func isUpstartEnabled(name string) (bool, error) {
+	override, err := ioutil.ReadFile(upstartConfDir + "/" + name + ".override")
+
+	if err == nil && hasStanza(string(override), "manual") {
+		return false, nil
+	}
+
+	conf, err := ioutil.ReadFile(getUpstartConfFile(name))
+
+	if err != nil {
+		return false, fmt.Errorf("Can't read config of service %s: %v", name, err)
+	}
+
+	data := string(conf)
+
+	return hasStanza(data, "start on") && !hasStanza(data, "manual"), nil
+}
+
+// hasStanza return true if upstart config contains given stanza
+func hasStanza(data, stanza string) bool {
+	for _, line := range strings.Split(data, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), stanza) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getSystemdUnitFile return path to systemd unit file
+func getSystemdUnitFile(name string) string {
+	if !strings.Contains(name, ".") {
+		name += ".service"
+	}
+
+	for _, dir := range systemdUnitDirs {
+		if fsutil.IsExist(dir + "/" + name) {
+			return dir + "/" + name
+		}
+	}
+
+	return ""
+}
+
+// getUpstartConfFile return path to upstart job config
+func getUpstartConfFile(name string) string {
+	return upstartConfDir + "/" + name + ".conf"
+}
+
+// getSysVInitDir return path to directory with SysV init scripts
+func getSysVInitDir() string {
+	for _, dir := range sysvInitDirs {
+		if fsutil.IsDir(dir) {
+			return dir
+		}
+	}
+
+	return ""
+}
+
+// getSysVScript return path to SysV init script
+func getSysVScript(name string) string {
+	dir := getSysVInitDir()
+
+	if dir == "" || name == "" || strings.Contains(name, "/") {
+		return ""
+	}
+
+	if !fsutil.IsExecutable(dir + "/" + name) {
+		return ""
+	}
+
+	return dir + "/" + name
+}
+
+// getErrorMessage return error message from command output
+func getErrorMessage(output []byte, err error) string {
+	message := strings.TrimSpace(string(output))
+
+	if message == "" {
+		return err.Error()
+	}
+
+	return message
+}
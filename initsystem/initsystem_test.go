@@ -0,0 +1,186 @@
+// +build linux
+
+package initsystem
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "pkg.re/check.v1"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const _SCRIPT = `#!/bin/sh
+case "$1" in
+  status) [ -f "$0.running" ] ;;
+  start) touch "$0.running" ;;
+  stop) rm -f "$0.running" ;;
+  restart) echo "Restart failed" ; exit 1 ;;
+esac
+`
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type InitSystemSuite struct {
+	TmpDir string
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func Test(t *testing.T) { TestingT(t) }
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var _ = Suite(&InitSystemSuite{})
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func (s *InitSystemSuite) SetUpSuite(c *C) {
+	s.TmpDir = c.MkDir()
+
+	systemdRunDir = s.TmpDir + "/systemd"
+	upstartConfDir = s.TmpDir + "/init"
+	upstartBin = s.TmpDir + "/initctl"
+	sysvInitDirs = []string{s.TmpDir + "/init.d"}
+	sysvRcDirs = s.TmpDir + "/rc[2-5].d"
+
+	os.Mkdir(s.TmpDir+"/init.d", 0755)
+	os.Mkdir(s.TmpDir+"/rc3.d", 0755)
+
+	err := ioutil.WriteFile(s.TmpDir+"/init.d/myapp", []byte(_SCRIPT), 0755)
+
+	if err != nil {
+		c.Fatal(err.Error())
+	}
+
+	ioutil.WriteFile(s.TmpDir+"/init.d/disabled", []byte(_SCRIPT), 0755)
+	os.Symlink("../init.d/myapp", s.TmpDir+"/rc3.d/S90myapp")
+}
+
+func (s *InitSystemSuite) TestDetection(c *C) {
+	c.Assert(Systemd(), Equals, false)
+	c.Assert(Upstart(), Equals, false)
+	c.Assert(SysV(), Equals, true)
+	c.Assert(Current(), Equals, SYSV)
+}
+
+func (s *InitSystemSuite) TestSysV(c *C) {
+	c.Assert(IsPresent("myapp"), Equals, true)
+	c.Assert(IsPresent("unknown"), Equals, false)
+	c.Assert(IsPresent("../init.d/myapp"), Equals, false)
+
+	enabled, err := IsEnabled("myapp")
+
+	c.Assert(err, IsNil)
+	c.Assert(enabled, Equals, true)
+
+	enabled, err = IsEnabled("disabled")
+
+	c.Assert(err, IsNil)
+	c.Assert(enabled, Equals, false)
+
+	_, err = IsEnabled("unknown")
+
+	c.Assert(err, NotNil)
+
+	works, err := IsWorks("myapp")
+
+	c.Assert(err, IsNil)
+	c.Assert(works, Equals, false)
+
+	c.Assert(Start("myapp"), IsNil)
+
+	works, _ = IsWorks("myapp")
+
+	c.Assert(works, Equals, true)
+
+	c.Assert(Stop("myapp"), IsNil)
+
+	works, _ = IsWorks("myapp")
+
+	c.Assert(works, Equals, false)
+
+	err = Restart("myapp")
+
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "Can't restart service myapp: Restart failed")
+}
+
+func (s *InitSystemSuite) TestCommand(c *C) {
+	cmd, err := Command(ACTION_START, "myapp")
+
+	c.Assert(err, IsNil)
+	c.Assert(cmd, DeepEquals, []string{s.TmpDir + "/init.d/myapp", "start"})
+
+	_, err = Command("reload", "myapp")
+
+	c.Assert(err, NotNil)
+
+	_, err = Command(ACTION_START, "")
+
+	c.Assert(err, NotNil)
+
+	_, err = Command(ACTION_START, "unknown")
+
+	c.Assert(err, NotNil)
+}
+
+func (s *InitSystemSuite) TestDryRun(c *C) {
+	DryRun = true
+
+	defer func() { DryRun = false }()
+
+	c.Assert(Start("myapp"), IsNil)
+	c.Assert(Restart("myapp"), IsNil)
+	c.Assert(Start("unknown"), NotNil)
+
+	works, _ := IsWorks("myapp")
+
+	c.Assert(works, Equals, false)
+}
+
+func (s *InitSystemSuite) TestUpstart(c *C) {
+	os.Mkdir(s.TmpDir+"/init", 0755)
+	ioutil.WriteFile(s.TmpDir+"/initctl", []byte("#!/bin/sh\necho \"$2 start/running, process 1\"\n"), 0755)
+	ioutil.WriteFile(s.TmpDir+"/init/job.conf", []byte("description \"Job\"\n\nstart on runlevel [2345]\n"), 0644)
+	ioutil.WriteFile(s.TmpDir+"/init/manual.conf", []byte("start on runlevel [2345]\n"), 0644)
+	ioutil.WriteFile(s.TmpDir+"/init/manual.override", []byte("manual\n"), 0644)
+
+	defer os.RemoveAll(s.TmpDir + "/init")
+
+	c.Assert(Current(), Equals, UPSTART)
+	c.Assert(IsPresent("job"), Equals, true)
+	c.Assert(IsPresent("myapp"), Equals, true)
+
+	enabled, err := IsEnabled("job")
+
+	c.Assert(err, IsNil)
+	c.Assert(enabled, Equals, true)
+
+	enabled, err = IsEnabled("manual")
+
+	c.Assert(err, IsNil)
+	c.Assert(enabled, Equals, false)
+
+	works, err := IsWorks("job")
+
+	c.Assert(err, IsNil)
+	c.Assert(works, Equals, true)
+
+	cmd, _ := Command(ACTION_STOP, "job")
+
+	c.Assert(cmd, DeepEquals, []string{s.TmpDir + "/initctl", "stop", "job"})
+
+	cmd, _ = Command(ACTION_STOP, "myapp")
+
+	c.Assert(cmd, DeepEquals, []string{s.TmpDir + "/init.d/myapp", "stop"})
+}
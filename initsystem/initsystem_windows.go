@@ -0,0 +1,101 @@
+// +build windows
+
+// Package initsystem provides methods for working with different init systems
+package initsystem
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Init system names
+const (
+	SYSV    = "sysv"
+	UPSTART = "upstart"
+	SYSTEMD = "systemd"
+)
+
+// Service actions
+const (
+	ACTION_START   = "start"
+	ACTION_STOP    = "stop"
+	ACTION_RESTART = "restart"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DryRun is dry-run mode flag, if set to true Start, Stop and Restart
+// don't execute any commands
+var DryRun = false
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrNoInitSystem is returned if supported init system can't be found
+var ErrNoInitSystem = errors.New("Can't find supported init system")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Systemd return true if systemd is used as init system
+func Systemd() bool {
+	return false
+}
+
+// Upstart return true if upstart is used as init system
+func Upstart() bool {
+	return false
+}
+
+// SysV return true if SysV init scripts are supported by system
+func SysV() bool {
+	return false
+}
+
+// Current return name of current init system (systemd, upstart or sysv)
+// or empty string if supported init system can't be found
+func Current() string {
+	return ""
+}
+
+// IsPresent return true if service with given name is present in system
+func IsPresent(name string) bool {
+	return false
+}
+
+// IsWorks return true if service with given name is works
+func IsWorks(name string) (bool, error) {
+	return false, nil
+}
+
+// IsEnabled return true if auto start is enabled for service with given name
+func IsEnabled(name string) (bool, error) {
+	return false, nil
+}
+
+// Start start service with given name
+func Start(name string) error {
+	return nil
+}
+
+// Stop stop service with given name
+func Stop(name string) error {
+	return nil
+}
+
+// Restart restart service with given name
+func Restart(name string) error {
+	return nil
+}
+
+// Command return command which will be executed for performing given
+// action (start, stop or restart) with service
+func Command(action, name string) ([]string, error) {
+	return nil, nil
+}
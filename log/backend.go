@@ -0,0 +1,56 @@
+package log
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Backend is interface for log backends which receive all messages
+// written to logger (e.g. syslog or journald)
+type Backend interface {
+	// Write write message with given level and fields to backend
+	Write(level int, msg string, fields map[string]interface{}) error
+
+	// Close close backend
+	Close() error
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// AddBackend add backend to global logger
+func AddBackend(backend Backend) {
+	Global.AddBackend(backend)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// AddBackend add backend to logger. All messages written to logger will
+// be also sent to backend (without time and prefixes).
+func (l *Logger) AddBackend(backend Backend) {
+	if l == nil || backend == nil {
+		return
+	}
+
+	l.mu.Lock()
+	l.backends = append(l.backends, backend)
+	l.mu.Unlock()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// writeToBackends send message to given backends and return first error
+func writeToBackends(backends []Backend, level int, msg string, fields map[string]interface{}) error {
+	var result error
+
+	for _, backend := range backends {
+		err := backend.Write(level, msg, fields)
+
+		if err != nil && result == nil {
+			result = err
+		}
+	}
+
+	return result
+}
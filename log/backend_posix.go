@@ -0,0 +1,224 @@
+// +build !windows
+
+package log
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log/syslog"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// JournaldSocket is path to journald socket
+var JournaldSocket = "/run/systemd/journal/socket"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SyslogBackend is backend which send messages to syslog
+type SyslogBackend struct {
+	w *syslog.Writer
+}
+
+// JournaldBackend is backend which send messages to systemd-journald
+type JournaldBackend struct {
+	identifier string
+	fields     map[string]string
+	conn       *net.UnixConn
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrInvalidFieldName is returned if journald field name is not valid
+var ErrInvalidFieldName = errors.New("Field name must contain only uppercase letters, numbers and underscores")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// syslogPriorities contains mapping log level -> syslog priority
+var syslogPriorities = map[int]syslog.Priority{
+	DEBUG: syslog.LOG_DEBUG,
+	INFO:  syslog.LOG_INFO,
+	WARN:  syslog.LOG_WARNING,
+	ERROR: syslog.LOG_ERR,
+	CRIT:  syslog.LOG_CRIT,
+	AUX:   syslog.LOG_NOTICE,
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// NewSyslogBackend create new syslog backend. If network is empty, messages
+// will be sent to local syslog socket, otherwise to remote syslog server
+// with given address (e.g. "udp", "syslog.domain.com:514"). If tag is empty,
+// process name is used.
+func NewSyslogBackend(network, addr, tag string) (*SyslogBackend, error) {
+	if tag == "" {
+		tag = filepath.Base(os.Args[0])
+	}
+
+	w, err := syslog.Dial(network, addr, syslog.LOG_INFO|syslog.LOG_USER, tag)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &SyslogBackend{w}, nil
+}
+
+// NewJournaldBackend create new journald backend. Given fields will be
+// added to every message. If identifier is empty, process name is used.
+func NewJournaldBackend(identifier string, fields map[string]string) (*JournaldBackend, error) {
+	for name := range fields {
+		if !isValidFieldName(name) {
+			return nil, ErrInvalidFieldName
+		}
+	}
+
+	if identifier == "" {
+		identifier = filepath.Base(os.Args[0])
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: JournaldSocket, Net: "unixgram"})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &JournaldBackend{identifier, fields, conn}, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Write write message with given level to syslog. Fields are appended
+// to message as key=value pairs.
+func (b *SyslogBackend) Write(level int, msg string, fields map[string]interface{}) error {
+	msg += formatFields(fields)
+
+	switch syslogPriorities[level] {
+	case syslog.LOG_DEBUG:
+		return b.w.Debug(msg)
+	case syslog.LOG_INFO:
+		return b.w.Info(msg)
+	case syslog.LOG_WARNING:
+		return b.w.Warning(msg)
+	case syslog.LOG_ERR:
+		return b.w.Err(msg)
+	case syslog.LOG_CRIT:
+		return b.w.Crit(msg)
+	}
+
+	return b.w.Notice(msg)
+}
+
+// Close close connection to syslog
+func (b *SyslogBackend) Close() error {
+	return b.w.Close()
+}
+
+// Write write message with given level to journald. Fields are sent as
+// journald fields with uppercase names.
+func (b *JournaldBackend) Write(level int, msg string, fields map[string]interface{}) error {
+	priority, ok := syslogPriorities[level]
+
+	if !ok {
+		priority = syslog.LOG_NOTICE
+	}
+
+	var buf bytes.Buffer
+
+	appendJournaldField(&buf, "MESSAGE", msg)
+	appendJournaldField(&buf, "PRIORITY", strconv.Itoa(int(priority)))
+	appendJournaldField(&buf, "SYSLOG_IDENTIFIER", b.identifier)
+
+	for name, value := range b.fields {
+		appendJournaldField(&buf, name, value)
+	}
+
+	for _, name := range getFieldNames(fields) {
+		fieldName := getJournaldFieldName(name)
+
+		switch fieldName {
+		case "", "MESSAGE", "PRIORITY", "SYSLOG_IDENTIFIER":
+			continue
+		}
+
+		appendJournaldField(&buf, fieldName, fmt.Sprint(fields[name]))
+	}
+
+	_, err := b.conn.Write(buf.Bytes())
+
+	return err
+}
+
+// Close close connection to journald
+func (b *JournaldBackend) Close() error {
+	return b.conn.Close()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// appendJournaldField append field to buffer using journald native protocol
+func appendJournaldField(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(name)
+
+	// Values with newlines must be serialized as binary data
+	if strings.Contains(value, "\n") {
+		size := make([]byte, 8)
+		binary.LittleEndian.PutUint64(size, uint64(len(value)))
+
+		buf.WriteByte('\n')
+		buf.Write(size)
+	} else {
+		buf.WriteByte('=')
+	}
+
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+}
+
+// getJournaldFieldName convert log field name to journald field name
+func getJournaldFieldName(name string) string {
+	result := []byte(strings.ToUpper(name))
+
+	for i, r := range result {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			continue
+		}
+
+		result[i] = '_'
+	}
+
+	return strings.TrimLeft(string(result), "_")
+}
+
+// isValidFieldName check journald field name
+func isValidFieldName(name string) bool {
+	if name == "" || name[0] == '_' {
+		return false
+	}
+
+	for _, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,66 @@
+// +build !linux, !darwin, windows
+
+package log
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// JournaldSocket is path to journald socket
+var JournaldSocket = ""
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SyslogBackend is backend which send messages to syslog
+type SyslogBackend struct{}
+
+// JournaldBackend is backend which send messages to systemd-journald
+type JournaldBackend struct{}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrInvalidFieldName is returned if journald field name is not valid
+var ErrInvalidFieldName = errors.New("Field name must contain only uppercase letters, numbers and underscores")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// NewSyslogBackend create new syslog backend
+func NewSyslogBackend(network, addr, tag string) (*SyslogBackend, error) {
+	return nil, errors.New("Syslog is not supported on this system")
+}
+
+// NewJournaldBackend create new journald backend
+func NewJournaldBackend(identifier string, fields map[string]string) (*JournaldBackend, error) {
+	return nil, errors.New("Journald is not supported on this system")
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Write write message with given level to syslog
+func (b *SyslogBackend) Write(level int, msg string, fields map[string]interface{}) error {
+	return nil
+}
+
+// Close close connection to syslog
+func (b *SyslogBackend) Close() error {
+	return nil
+}
+
+// Write write message with given level to journald
+func (b *JournaldBackend) Write(level int, msg string, fields map[string]interface{}) error {
+	return nil
+}
+
+// Close close connection to journald
+func (b *JournaldBackend) Close() error {
+	return nil
+}
@@ -38,6 +38,26 @@ func Example_Logger() {
 	logger.Error("This is error message")
 	logger.Crit("This is critical message")
 
+	// Messages can contain fmtc color tags, which are removed from
+	// messages written to file
+	logger.Info("User {*}%s{!} logged in", "bob")
+
+	// You can add custom fields to messages
+	logger.WithFields(map[string]interface{}{"user": "bob", "id": 123}).Info("User logged in")
+
+	// Messages can be written to file in JSON format (messages printed to
+	// terminal are always written in human-readable format)
+	logger.UseJSON = true
+
+	// Entries can have names, so you can define different minimal levels
+	// for different subsystems
+	logger.MinLevelFor("db", DEBUG)
+	logger.Named("db").Debug("Connection established")
+
+	// Minimal level can be changed at runtime (e.g. after config reload),
+	// also you can toggle debug level by USR1 signal
+	logger.ToggleDebugOnSignal()
+
 	// AUX message it's unskippable message which will be printed to log file with
 	// any minimum level
 	logger.Aux("This is aux message")
@@ -45,6 +65,26 @@ func Example_Logger() {
 	// For log rotation we provide method Reopen
 	logger.Reopen()
 
+	// Or you can enable rotation by size (50 MB) and by day with
+	// keeping 7 compressed archives
+	logger.EnableRotation(Rotation{MaxSize: 50 * 1024 * 1024, Daily: true, Keep: 7})
+
+	// If log is rotated by logrotate, log file can be reopened on HUP signal
+	logger.ReopenOnSignal()
+
+	// Messages can be also sent to syslog or journald
+	syslogBackend, err := NewSyslogBackend("", "", "myapp")
+
+	if err == nil {
+		logger.AddBackend(syslogBackend)
+	}
+
+	journaldBackend, err := NewJournaldBackend("myapp", map[string]string{"SERVICE": "api"})
+
+	if err == nil {
+		logger.AddBackend(journaldBackend)
+	}
+
 	// If buffered IO is used, you should flush data before exit
 	logger.Flush()
 }
@@ -0,0 +1,250 @@
+package log
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Entry is logger entry with custom fields
+type Entry struct {
+	logger *Logger
+	name   string
+	fields map[string]interface{}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// JSONTimeFormat contains format string for time in JSON records
+var JSONTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// levelNames contains level names used in JSON records
+var levelNames = map[int]string{
+	DEBUG: "debug",
+	INFO:  "info",
+	WARN:  "warn",
+	ERROR: "error",
+	CRIT:  "crit",
+	AUX:   "aux",
+}
+
+// reservedFields contains names of fields used in every JSON record
+var reservedFields = map[string]bool{
+	"timestamp": true,
+	"level":     true,
+	"msg":       true,
+	"caller":    true,
+}
+
+// pkgDir is path to directory with package sources
+var pkgDir = getPkgDir()
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// WithFields return global logger entry with given fields
+func WithFields(fields map[string]interface{}) *Entry {
+	return Global.WithFields(fields)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// WithFields return logger entry with given fields. Fields are added to
+// every message as key=value pairs, or as separate keys in JSON records
+// (fields with names timestamp, level, msg and caller are ignored).
+func (l *Logger) WithFields(fields map[string]interface{}) *Entry {
+	return &Entry{logger: l, fields: mergeFields(nil, fields)}
+}
+
+// WithFields return new entry with fields of current entry and given fields
+func (e *Entry) WithFields(fields map[string]interface{}) *Entry {
+	if e == nil {
+		return nil
+	}
+
+	return &Entry{logger: e.logger, name: e.name, fields: mergeFields(e.fields, fields)}
+}
+
+// Named return new entry with fields of current entry and given name
+// appended to entry name (e.g. "db" -> "db.pool")
+func (e *Entry) Named(name string) *Entry {
+	if e == nil {
+		return nil
+	}
+
+	if e.name != "" {
+		name = e.name + "." + name
+	}
+
+	return &Entry{logger: e.logger, name: name, fields: e.fields}
+}
+
+// Print write message with fields to logger output
+func (e *Entry) Print(level int, f string, a ...interface{}) (int, error) {
+	if e == nil || e.logger == nil {
+		return -1, ErrLoggerIsNil
+	}
+
+	return e.logger.print(level, e.name, e.fields, f, a...)
+}
+
+// Debug write debug message with fields to logger output
+func (e *Entry) Debug(f string, a ...interface{}) (int, error) {
+	return e.Print(DEBUG, f, a...)
+}
+
+// Info write info message with fields to logger output
+func (e *Entry) Info(f string, a ...interface{}) (int, error) {
+	return e.Print(INFO, f, a...)
+}
+
+// Warn write warning message with fields to logger output
+func (e *Entry) Warn(f string, a ...interface{}) (int, error) {
+	return e.Print(WARN, f, a...)
+}
+
+// Error write error message with fields to logger output
+func (e *Entry) Error(f string, a ...interface{}) (int, error) {
+	return e.Print(ERROR, f, a...)
+}
+
+// Crit write critical message with fields to logger output
+func (e *Entry) Crit(f string, a ...interface{}) (int, error) {
+	return e.Print(CRIT, f, a...)
+}
+
+// Aux write unskippable message with fields to logger output
+func (e *Entry) Aux(f string, a ...interface{}) (int, error) {
+	return e.Print(AUX, f, a...)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// mergeFields return new map with fields from both maps
+func mergeFields(current, fields map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(current)+len(fields))
+
+	for name, value := range current {
+		result[name] = value
+	}
+
+	for name, value := range fields {
+		result[name] = value
+	}
+
+	return result
+}
+
+// formatFields format fields as key=value pairs
+func formatFields(fields map[string]interface{}) string {
+	if len(fields) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+
+	for _, name := range getFieldNames(fields) {
+		value := fmt.Sprint(fields[name])
+
+		if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+
+		buf.WriteString(" " + name + "=" + value)
+	}
+
+	return buf.String()
+}
+
+// encodeJSON encode message to JSON record
+func encodeJSON(level int, msg, caller string, fields map[string]interface{}) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(`{"timestamp":` + encodeJSONValue(time.Now().Format(JSONTimeFormat)))
+	buf.WriteString(`,"level":` + encodeJSONValue(levelNames[level]))
+	buf.WriteString(`,"msg":` + encodeJSONValue(msg))
+
+	if caller != "" {
+		buf.WriteString(`,"caller":` + encodeJSONValue(caller))
+	}
+
+	for _, name := range getFieldNames(fields) {
+		if reservedFields[name] {
+			continue
+		}
+
+		buf.WriteString("," + encodeJSONValue(name) + ":" + encodeJSONValue(fields[name]))
+	}
+
+	buf.WriteString("}")
+
+	return buf.String()
+}
+
+// encodeJSONValue encode value to JSON, values which can't be encoded are
+// converted to strings
+func encodeJSONValue(value interface{}) string {
+	if err, ok := value.(error); ok {
+		value = err.Error()
+	}
+
+	data, err := json.Marshal(value)
+
+	if err != nil {
+		data, _ = json.Marshal(fmt.Sprint(value))
+	}
+
+	return string(data)
+}
+
+// getFieldNames return sorted slice with field names
+func getFieldNames(fields map[string]interface{}) []string {
+	var result []string
+
+	for name := range fields {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// getCaller return file name and line of code which called logger
+func getCaller() string {
+	for i := 1; ; i++ {
+		_, file, line, ok := runtime.Caller(i)
+
+		if !ok {
+			return ""
+		}
+
+		if filepath.Dir(file) == pkgDir && !strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		return filepath.Base(file) + ":" + strconv.Itoa(line)
+	}
+}
+
+// getPkgDir return path to directory with package sources
+func getPkgDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}
@@ -0,0 +1,136 @@
+package log
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"pkg.re/essentialkaos/ek.v7/signal"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Named return global logger entry with given name
+func Named(name string) *Entry {
+	return Global.Named(name)
+}
+
+// MinLevelFor defines minimal logging level for global logger entries
+// with names which start with given prefix
+func MinLevelFor(prefix string, level interface{}) error {
+	return Global.MinLevelFor(prefix, level)
+}
+
+// RemoveMinLevelFor remove minimal logging level defined for given prefix
+// from global logger
+func RemoveMinLevelFor(prefix string) {
+	Global.RemoveMinLevelFor(prefix)
+}
+
+// ToggleDebugOnSignal toggle debug level for global logger on USR1 signal
+func ToggleDebugOnSignal() {
+	Global.ToggleDebugOnSignal()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Named return logger entry with given name (e.g. name of subsystem).
+// Name is added to every message as "logger" field and can be used for
+// defining different minimal levels with MinLevelFor.
+func (l *Logger) Named(name string) *Entry {
+	return &Entry{logger: l, name: name}
+}
+
+// MinLevelFor defines minimal logging level for entries with names which
+// start with given prefix. If several prefixes match entry name, level
+// for longest prefix is used.
+func (l *Logger) MinLevelFor(prefix string, level interface{}) error {
+	if l == nil {
+		return ErrLoggerIsNil
+	}
+
+	if prefix == "" {
+		return l.MinLevel(level)
+	}
+
+	levelCode, err := parseLevel(level)
+
+	if err != nil {
+		return err
+	}
+
+	l.levelsMu.Lock()
+
+	if l.levels == nil {
+		l.levels = make(map[string]int)
+	}
+
+	l.levels[prefix] = levelCode
+
+	l.levelsMu.Unlock()
+
+	return nil
+}
+
+// RemoveMinLevelFor remove minimal logging level defined for given prefix
+func (l *Logger) RemoveMinLevelFor(prefix string) {
+	if l == nil {
+		return
+	}
+
+	l.levelsMu.Lock()
+	delete(l.levels, prefix)
+	l.levelsMu.Unlock()
+}
+
+// ToggleDebugOnSignal toggle debug level on USR1 signal. First signal
+// set minimal level to DEBUG, next signal restore previous level.
+func (l *Logger) ToggleDebugOnSignal() {
+	if l == nil {
+		return
+	}
+
+	prevLevel := int32(-1)
+
+	signal.Handlers{
+		signal.USR1: func() {
+			if prevLevel == -1 {
+				prevLevel = atomic.SwapInt32(&l.level, DEBUG)
+			} else {
+				atomic.StoreInt32(&l.level, prevLevel)
+				prevLevel = -1
+			}
+		},
+	}.Track()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getMinLevel return minimal logging level for entry with given name
+func (l *Logger) getMinLevel(name string) int {
+	if name != "" {
+		l.levelsMu.RLock()
+
+		var prefixSize, level int
+
+		for prefix, prefixLevel := range l.levels {
+			if len(prefix) > prefixSize && strings.HasPrefix(name, prefix) {
+				prefixSize, level = len(prefix), prefixLevel
+			}
+		}
+
+		l.levelsMu.RUnlock()
+
+		if prefixSize != 0 {
+			return level
+		}
+	}
+
+	return int(atomic.LoadInt32(&l.level))
+}
@@ -15,7 +15,11 @@ import (
 	"io"
 	"os"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -44,13 +48,25 @@ type Logger struct {
 	PrefixWarn  bool // Prefix for warning messages
 	PrefixError bool // Prefix for error messages
 	PrefixCrit  bool // Prefix for critical error messages
+	UseJSON     bool // Write messages to file in JSON format
 
 	file     string
 	fd       *os.File
 	w        *bufio.Writer
-	level    int
+	level    int32
 	perms    os.FileMode
 	useBufIO bool
+	rotation *Rotation
+	size     int64
+	date     string
+	backends []Backend
+
+	levels   map[string]int
+	levelsMu sync.RWMutex
+
+	// mu protects output (fd, w, size and date), so output file can be
+	// safely rotated or reopened while messages are written
+	mu sync.Mutex
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -190,6 +206,9 @@ func (l *Logger) Reopen() error {
 		return ErrLoggerIsNil
 	}
 
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	if l.fd == nil {
 		return ErrOutputNotSet
 	}
@@ -200,7 +219,7 @@ func (l *Logger) Reopen() error {
 
 	l.fd.Close()
 
-	return l.Set(l.file, l.perms)
+	return l.set(l.file, l.perms)
 }
 
 // MinLevel defines minimal logging level
@@ -209,26 +228,31 @@ func (l *Logger) MinLevel(level interface{}) error {
 		return ErrLoggerIsNil
 	}
 
-	levelCode, err := convertMinLevelValue(level)
+	levelCode, err := parseLevel(level)
 
 	if err != nil {
 		return err
 	}
 
-	switch {
-	case levelCode < DEBUG:
-		levelCode = DEBUG
-	case levelCode > CRIT:
-		levelCode = CRIT
-	}
-
-	l.level = levelCode
+	atomic.StoreInt32(&l.level, int32(levelCode))
 
 	return nil
 }
 
+// Level return current minimal logging level
+func (l *Logger) Level() int {
+	if l == nil {
+		return -1
+	}
+
+	return int(atomic.LoadInt32(&l.level))
+}
+
 // EnableBufIO enable buffered I/O support
 func (l *Logger) EnableBufIO(interval time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	l.useBufIO = true
 
 	if l.fd != nil {
@@ -240,79 +264,21 @@ func (l *Logger) EnableBufIO(interval time.Duration) {
 
 // Set change logger output target
 func (l *Logger) Set(file string, perms os.FileMode) error {
-	fd, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_APPEND, perms)
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-	if err != nil {
-		return err
-	}
-
-	// Flush data if writer exist
-	if l.w != nil {
-		l.w.Flush()
-		l.w = nil
-	}
-
-	if l.fd != nil {
-		l.fd.Close()
-		l.fd = nil
-	}
-
-	l.fd, l.file, l.perms = fd, file, perms
-
-	if l.useBufIO {
-		l.w = bufio.NewWriter(l.fd)
-	}
-
-	return nil
+	return l.set(file, perms)
 }
 
-// Print write message to logger output
+// Print write message to logger output. Format string can contain fmtc color
+// tags, which are rendered for standard output and removed from messages
+// written to file (arguments are always printed as is).
 func (l *Logger) Print(level int, f string, a ...interface{}) (int, error) {
 	if l == nil {
 		return -1, ErrLoggerIsNil
 	}
 
-	if l.level > level {
-		return 0, nil
-	}
-
-	var w io.Writer
-
-	if l.fd == nil {
-		switch level {
-		case ERROR, CRIT:
-			w = os.Stderr
-		default:
-			w = os.Stdout
-		}
-	} else {
-		if l.w != nil {
-			w = l.w
-		} else {
-			w = l.fd
-		}
-	}
-
-	var showPrefixes bool
-
-	switch {
-	case level == DEBUG && l.PrefixDebug,
-		level == INFO && l.PrefixInfo,
-		level == WARN && l.PrefixWarn,
-		level == ERROR && l.PrefixError,
-		level == CRIT && l.PrefixCrit:
-		showPrefixes = true
-	}
-
-	if f == "" || f[len(f)-1:] != "\n" {
-		f += "\n"
-	}
-
-	if showPrefixes {
-		return fmt.Fprintf(w, "%s %s %s", getTime(), PrefixMap[level], fmt.Sprintf(f, a...))
-	}
-
-	return fmt.Fprintf(w, "%s %s", getTime(), fmt.Sprintf(f, a...))
+	return l.print(level, "", nil, f, a...)
 }
 
 // Flush write buffered data to file
@@ -321,6 +287,9 @@ func (l *Logger) Flush() error {
 		return ErrLoggerIsNil
 	}
 
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	if l.w == nil {
 		return nil
 	}
@@ -384,6 +353,126 @@ func (l *Logger) Aux(f string, a ...interface{}) (int, error) {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// print write message with given fields to logger output
+func (l *Logger) print(level int, name string, fields map[string]interface{}, f string, a ...interface{}) (int, error) {
+	if l.getMinLevel(name) > level {
+		return 0, nil
+	}
+
+	f = strings.TrimSuffix(f, "\n")
+	msg := fmt.Sprintf(fmtc.Clean(f), a...)
+
+	if name != "" {
+		fields = mergeFields(fields, map[string]interface{}{"logger": name})
+	}
+
+	var n int
+	var err error
+
+	l.mu.Lock()
+
+	switch {
+	case l.fd == nil:
+		// Color tags are rendered only in format string, so braces in
+		// arguments are printed as is
+		n, err = l.writeToStd(level, getTime()+" "+l.getPrefix(level)+fmt.Sprintf(fmtc.Sprint(f), a...)+formatFields(fields)+"\n")
+	case l.UseJSON:
+		n, err = l.writeToFile(encodeJSON(level, msg, getCaller(), fields) + "\n")
+	default:
+		n, err = l.writeToFile(getTime() + " " + l.getPrefix(level) + msg + formatFields(fields) + "\n")
+	}
+
+	backends := l.backends
+
+	l.mu.Unlock()
+
+	if len(backends) != 0 {
+		backendErr := writeToBackends(backends, level, msg, fields)
+
+		if err == nil {
+			err = backendErr
+		}
+	}
+
+	return n, err
+}
+
+// set change logger output target (must be called under lock)
+func (l *Logger) set(file string, perms os.FileMode) error {
+	fd, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_APPEND, perms)
+
+	if err != nil {
+		return err
+	}
+
+	// Flush data if writer exist
+	if l.w != nil {
+		l.w.Flush()
+		l.w = nil
+	}
+
+	if l.fd != nil {
+		l.fd.Close()
+		l.fd = nil
+	}
+
+	l.fd, l.file, l.perms = fd, file, perms
+	l.size, l.date = 0, getDate(time.Now())
+
+	if info, err := fd.Stat(); err == nil && info.Size() != 0 {
+		l.size, l.date = info.Size(), getDate(info.ModTime())
+	}
+
+	if l.useBufIO {
+		l.w = bufio.NewWriter(l.fd)
+	}
+
+	return nil
+}
+
+// getPrefix return prefix for given level if prefixes for this
+// level are enabled
+func (l *Logger) getPrefix(level int) string {
+	switch {
+	case level == DEBUG && l.PrefixDebug,
+		level == INFO && l.PrefixInfo,
+		level == WARN && l.PrefixWarn,
+		level == ERROR && l.PrefixError,
+		level == CRIT && l.PrefixCrit:
+		return PrefixMap[level] + " "
+	}
+
+	return ""
+}
+
+// writeToStd write message to standard output or error output
+func (l *Logger) writeToStd(level int, msg string) (int, error) {
+	var w io.Writer = os.Stdout
+
+	if level == ERROR || level == CRIT {
+		w = os.Stderr
+	}
+
+	return io.WriteString(w, msg)
+}
+
+// writeToFile write message to output file (must be called under lock)
+func (l *Logger) writeToFile(msg string) (int, error) {
+	l.checkRotation(len(msg))
+
+	var w io.Writer = l.fd
+
+	if l.w != nil {
+		w = l.w
+	}
+
+	n, err := io.WriteString(w, msg)
+
+	l.size += int64(n)
+
+	return n, err
+}
+
 func (l *Logger) flushDaemon(interval time.Duration) {
 	for range time.NewTicker(interval).C {
 		l.Flush()
@@ -396,6 +485,24 @@ func getTime() string {
 	return "[ " + time.Now().Format(TimeFormat) + " ]"
 }
 
+// parseLevel convert level value to level code
+func parseLevel(level interface{}) (int, error) {
+	levelCode, err := convertMinLevelValue(level)
+
+	if err != nil {
+		return -1, err
+	}
+
+	switch {
+	case levelCode < DEBUG:
+		levelCode = DEBUG
+	case levelCode > CRIT:
+		levelCode = CRIT
+	}
+
+	return levelCode, nil
+}
+
 func convertMinLevelValue(level interface{}) (int, error) {
 	switch level.(type) {
 
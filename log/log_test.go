@@ -8,15 +8,22 @@ package log
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"compress/gzip"
+	"encoding/json"
 	"io/ioutil"
+	"net"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	. "pkg.re/check.v1"
 
+	"pkg.re/essentialkaos/ek.v7/fmtc"
 	"pkg.re/essentialkaos/ek.v7/fsutil"
+	"pkg.re/essentialkaos/ek.v7/signal"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -182,6 +189,55 @@ func (ls *LogSuite) TestStdOutput(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (ls *LogSuite) TestColorTags(c *C) {
+	logfile := ls.TempDir + "/colors.log"
+	l, err := New(logfile, 0600)
+
+	c.Assert(l, Not(IsNil))
+	c.Assert(err, IsNil)
+
+	c.Assert(fsutil.GetPerms(logfile), Equals, os.FileMode(0600))
+
+	l.Info("{g}Test{!} {*}info{!} %s", "{r}")
+	l.Warn("{y}Test warn{!}")
+
+	data, err := ioutil.ReadFile(logfile)
+
+	c.Assert(err, IsNil)
+
+	dataSlice := strings.Split(string(data[:]), "\n")
+
+	c.Assert(len(dataSlice), Equals, 3)
+	c.Assert(dataSlice[0][28:], Equals, "Test info {r}")
+	c.Assert(dataSlice[1][28:], Equals, "[WARNING] Test warn")
+
+	std := &Logger{}
+
+	_, err = std.Info("{g}Test{!} info")
+
+	c.Assert(err, IsNil)
+
+	// Color tags in arguments must be printed as is
+	r, w, err := os.Pipe()
+
+	c.Assert(err, IsNil)
+
+	stdout := os.Stdout
+	os.Stdout = w
+	fmtc.DisableColors = true
+
+	std.Info("{g}Test{!} %s", "{r}data{!}")
+
+	os.Stdout = stdout
+	fmtc.DisableColors = false
+	w.Close()
+
+	data, err = ioutil.ReadAll(r)
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.HasSuffix(string(data), " Test {r}data{!}\n"), Equals, true)
+}
+
 func (ls *LogSuite) TestWithoutPrefixes(c *C) {
 	logfile := ls.TempDir + "/file1.log"
 	l, err := New(logfile, 0644)
@@ -426,3 +482,415 @@ func (ls *LogSuite) TestBufIO(c *C) {
 
 	c.Assert(fsutil.GetSize(logfile), Not(Equals), fileSize)
 }
+
+func (ls *LogSuite) TestRotation(c *C) {
+	logfile := ls.TempDir + "/rotation.log"
+	l, err := New(logfile, 0640)
+
+	c.Assert(l, Not(IsNil))
+	c.Assert(err, IsNil)
+
+	l.EnableRotation(Rotation{MaxSize: 100, Keep: 2})
+
+	for i := 0; i < 8; i++ {
+		l.Info("Test message %d", i)
+	}
+
+	c.Assert(fsutil.IsExist(logfile+".1.gz"), Equals, true)
+	c.Assert(fsutil.IsExist(logfile+".2.gz"), Equals, true)
+	c.Assert(fsutil.IsExist(logfile+".3.gz"), Equals, false)
+	c.Assert(fsutil.GetPerms(logfile+".1.gz"), Equals, os.FileMode(0640))
+	c.Assert(fsutil.GetSize(logfile) <= 100, Equals, true)
+
+	fd, err := os.Open(logfile + ".1.gz")
+
+	c.Assert(err, IsNil)
+
+	gr, err := gzip.NewReader(fd)
+
+	c.Assert(err, IsNil)
+
+	data, err := ioutil.ReadAll(gr)
+
+	fd.Close()
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(string(data), "Test message 5"), Equals, true)
+
+	l.EnableRotation(Rotation{Daily: true, Keep: 1})
+
+	l.date = "20000101"
+	l.Info("Test message")
+
+	c.Assert(fsutil.IsExist(logfile+".2.gz"), Equals, false)
+	c.Assert(l.date, Equals, getDate(time.Now()))
+
+	l.EnableRotation(Rotation{})
+
+	c.Assert(l.Rotate(), IsNil)
+	c.Assert(fsutil.IsExist(logfile+".1.gz"), Equals, true)
+	c.Assert(fsutil.GetSize(logfile), Equals, int64(0))
+
+	l.ReopenOnSignal()
+
+	err = signal.Send(os.Getpid(), signal.HUP)
+
+	c.Assert(err, IsNil)
+
+	var nl *Logger
+
+	nl.EnableRotation(Rotation{})
+	nl.ReopenOnSignal()
+
+	c.Assert(nl.Rotate(), Equals, ErrLoggerIsNil)
+	c.Assert((&Logger{}).Rotate(), Equals, ErrOutputNotSet)
+	c.Assert(Rotate(), Equals, ErrOutputNotSet)
+}
+
+func (ls *LogSuite) TestConcurrentRotation(c *C) {
+	logfile := ls.TempDir + "/concurrent.log"
+	l, err := New(logfile, 0644)
+
+	c.Assert(err, IsNil)
+
+	l.EnableBufIO(time.Millisecond)
+	l.EnableRotation(Rotation{MaxSize: 1024, Keep: 2})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			for j := 0; j < 200; j++ {
+				l.Info("Test message %d", j)
+			}
+
+			wg.Done()
+		}()
+	}
+
+	for i := 0; i < 5; i++ {
+		c.Assert(l.Rotate(), IsNil)
+		c.Assert(l.Reopen(), IsNil)
+	}
+
+	wg.Wait()
+
+	c.Assert(l.Flush(), IsNil)
+	c.Assert(fsutil.IsExist(logfile+".1.gz"), Equals, true)
+}
+
+func (ls *LogSuite) TestConcurrentBackends(c *C) {
+	l := &Logger{level: DEBUG}
+	done := make(chan bool)
+
+	go func() {
+		for i := 0; i < 100; i++ {
+			l.Info("Test message %d", i)
+		}
+
+		close(done)
+	}()
+
+	backends := make([]*testBackend, 10)
+
+	for i := range backends {
+		backends[i] = &testBackend{}
+		l.AddBackend(backends[i])
+	}
+
+	<-done
+
+	c.Assert(l.backends, HasLen, 10)
+}
+
+func (ls *LogSuite) TestBackends(c *C) {
+	l := &Logger{level: DEBUG}
+	b := &testBackend{}
+
+	l.AddBackend(b)
+	l.AddBackend(nil)
+
+	l.Info("{g}Test{!} info %d", 1)
+	l.Crit("Test crit\n")
+	l.Debug("Test debug")
+
+	c.Assert(b.data, DeepEquals, []string{"1:Test info 1", "4:Test crit", "0:Test debug"})
+
+	l.MinLevel(WARN)
+	l.Info("Test info")
+
+	c.Assert(b.data, HasLen, 3)
+
+	b.err = ErrOutputNotSet
+	_, err := l.Aux("Test aux")
+
+	c.Assert(err, Equals, ErrOutputNotSet)
+
+	var nl *Logger
+
+	nl.AddBackend(b)
+}
+
+func (ls *LogSuite) TestSyslogBackend(c *C) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+
+	c.Assert(err, IsNil)
+
+	defer conn.Close()
+
+	b, err := NewSyslogBackend("udp", conn.LocalAddr().String(), "")
+
+	c.Assert(err, IsNil)
+	c.Assert(b, NotNil)
+
+	l := &Logger{}
+	l.AddBackend(b)
+	l.Error("Test error %d", ERROR)
+
+	buf := make([]byte, 1024)
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, _, err := conn.ReadFrom(buf)
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.HasPrefix(string(buf[:n]), "<11>"), Equals, true)
+	c.Assert(strings.HasSuffix(string(buf[:n]), "Test error 3\n"), Equals, true)
+
+	c.Assert(b.Close(), IsNil)
+
+	_, err = NewSyslogBackend("unknown", "", "test")
+
+	c.Assert(err, NotNil)
+}
+
+func (ls *LogSuite) TestJournaldBackend(c *C) {
+	JournaldSocket = ls.TempDir + "/journal.sock"
+
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: JournaldSocket, Net: "unixgram"})
+
+	c.Assert(err, IsNil)
+
+	defer conn.Close()
+
+	_, err = NewJournaldBackend("test", map[string]string{"_PID": "1"})
+
+	c.Assert(err, Equals, ErrInvalidFieldName)
+
+	_, err = NewJournaldBackend("test", map[string]string{"bad-name": "1"})
+
+	c.Assert(err, Equals, ErrInvalidFieldName)
+
+	b, err := NewJournaldBackend("test", map[string]string{"SERVICE": "api"})
+
+	c.Assert(err, IsNil)
+	c.Assert(b, NotNil)
+
+	c.Assert(b.Write(WARN, "Test warn", nil), IsNil)
+
+	buf := make([]byte, 1024)
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, err := conn.Read(buf)
+
+	c.Assert(err, IsNil)
+	c.Assert(string(buf[:n]), Equals, "MESSAGE=Test warn\nPRIORITY=4\nSYSLOG_IDENTIFIER=test\nSERVICE=api\n")
+
+	c.Assert(b.Write(AUX, "A\nB", nil), IsNil)
+
+	n, err = conn.Read(buf)
+
+	c.Assert(err, IsNil)
+	c.Assert(string(buf[:n]), Equals, "MESSAGE\n\x03\x00\x00\x00\x00\x00\x00\x00A\nB\nPRIORITY=5\nSYSLOG_IDENTIFIER=test\nSERVICE=api\n")
+
+	l := &Logger{}
+	l.AddBackend(b)
+	l.WithFields(map[string]interface{}{"user-id": 12, "_path": "/a b", "message": "ignored"}).Info("Test info")
+
+	n, err = conn.Read(buf)
+
+	c.Assert(err, IsNil)
+	c.Assert(string(buf[:n]), Equals, "MESSAGE=Test info\nPRIORITY=6\nSYSLOG_IDENTIFIER=test\nSERVICE=api\nPATH=/a b\nUSER_ID=12\n")
+
+	c.Assert(b.Close(), IsNil)
+
+	JournaldSocket = ls.TempDir + "/not-exist.sock"
+
+	_, err = NewJournaldBackend("", nil)
+
+	c.Assert(err, NotNil)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type testBackend struct {
+	data []string
+	err  error
+}
+
+func (b *testBackend) Write(level int, msg string, fields map[string]interface{}) error {
+	b.data = append(b.data, strconv.Itoa(level)+":"+msg+formatFields(fields))
+	return b.err
+}
+
+func (b *testBackend) Close() error {
+	return nil
+}
+
+func (ls *LogSuite) TestFields(c *C) {
+	logfile := ls.TempDir + "/fields.log"
+	l, err := New(logfile, 0644)
+
+	c.Assert(l, Not(IsNil))
+	c.Assert(err, IsNil)
+
+	b := &testBackend{}
+	l.AddBackend(b)
+
+	e := l.WithFields(map[string]interface{}{"user": "bob", "id": 12})
+	e.Info("Test {*}info{!}")
+	e.WithFields(map[string]interface{}{"path": "/a b", "id": 13}).Warn("Test warn")
+
+	data, err := ioutil.ReadFile(logfile)
+
+	c.Assert(err, IsNil)
+
+	dataSlice := strings.Split(string(data[:]), "\n")
+
+	c.Assert(len(dataSlice), Equals, 3)
+	c.Assert(dataSlice[0][28:], Equals, "Test info id=12 user=bob")
+	c.Assert(dataSlice[1][28:], Equals, `[WARNING] Test warn id=13 path="/a b" user=bob`)
+
+	c.Assert(b.data, DeepEquals, []string{"1:Test info id=12 user=bob", `2:Test warn id=13 path="/a b" user=bob`})
+
+	var ne *Entry
+
+	_, err = ne.Info("Test")
+
+	c.Assert(err, Equals, ErrLoggerIsNil)
+	c.Assert(ne.WithFields(nil), IsNil)
+
+	_, err = WithFields(nil).Debug("Test")
+
+	c.Assert(err, IsNil)
+}
+
+func (ls *LogSuite) TestJSON(c *C) {
+	logfile := ls.TempDir + "/json.log"
+	l, err := New(logfile, 0644)
+
+	c.Assert(l, Not(IsNil))
+	c.Assert(err, IsNil)
+
+	l.UseJSON = true
+	l.MinLevel(DEBUG)
+
+	l.Info("Test {g}info{!} \"%s\"", "message")
+
+	e := l.WithFields(map[string]interface{}{
+		"user":  "bob",
+		"msg":   "ignored",
+		"count": 3,
+		"err":   ErrOutputNotSet,
+		"fn":    func() {},
+	})
+
+	e.Error("Test error")
+	e.Debug("Test debug")
+	e.Crit("Test crit")
+	e.Aux("Test aux")
+
+	data, err := ioutil.ReadFile(logfile)
+
+	c.Assert(err, IsNil)
+
+	dataSlice := strings.Split(string(data[:]), "\n")
+
+	c.Assert(len(dataSlice), Equals, 6)
+
+	record := make(map[string]interface{})
+
+	c.Assert(json.Unmarshal([]byte(dataSlice[0]), &record), IsNil)
+	c.Assert(record["level"], Equals, "info")
+	c.Assert(record["msg"], Equals, "Test info \"message\"")
+	c.Assert(strings.HasPrefix(record["caller"].(string), "log_test.go:"), Equals, true)
+	c.Assert(record["timestamp"], NotNil)
+
+	record = make(map[string]interface{})
+
+	c.Assert(json.Unmarshal([]byte(dataSlice[1]), &record), IsNil)
+	c.Assert(record["level"], Equals, "error")
+	c.Assert(record["msg"], Equals, "Test error")
+	c.Assert(record["user"], Equals, "bob")
+	c.Assert(record["count"], Equals, float64(3))
+	c.Assert(record["err"], Equals, "Output file is not set")
+	c.Assert(record["fn"], NotNil)
+
+	c.Assert(dataSlice[2], Matches, `.*"level":"debug".*`)
+	c.Assert(dataSlice[3], Matches, `.*"level":"crit".*`)
+	c.Assert(dataSlice[4], Matches, `.*"level":"aux".*`)
+}
+
+func (ls *LogSuite) TestNamedLevels(c *C) {
+	l := &Logger{level: INFO}
+	b := &testBackend{}
+
+	l.AddBackend(b)
+
+	c.Assert(l.MinLevelFor("db", DEBUG), IsNil)
+	c.Assert(l.MinLevelFor("db.pool", "error"), IsNil)
+	c.Assert(l.MinLevelFor("http", "abcd"), NotNil)
+
+	db := l.Named("db")
+	pool := db.Named("pool").WithFields(map[string]interface{}{"id": 1})
+
+	db.Debug("Test db debug")
+	pool.Warn("Test pool warn")
+	pool.Error("Test pool error")
+	l.Named("http").Debug("Test http debug")
+	l.Debug("Test debug")
+
+	c.Assert(b.data, DeepEquals, []string{
+		"0:Test db debug logger=db",
+		"3:Test pool error id=1 logger=db.pool",
+	})
+
+	l.RemoveMinLevelFor("db")
+	b.data = nil
+
+	db.Debug("Test db debug")
+	db.Info("Test db info")
+
+	c.Assert(b.data, DeepEquals, []string{"1:Test db info logger=db"})
+
+	c.Assert(l.MinLevelFor("", WARN), IsNil)
+	c.Assert(l.Level(), Equals, WARN)
+
+	var nl *Logger
+	var ne *Entry
+
+	c.Assert(nl.MinLevelFor("db", DEBUG), Equals, ErrLoggerIsNil)
+	c.Assert(nl.Level(), Equals, -1)
+	c.Assert(ne.Named("test"), IsNil)
+
+	nl.RemoveMinLevelFor("db")
+	nl.ToggleDebugOnSignal()
+
+	c.Assert(MinLevelFor("db", DEBUG), IsNil)
+
+	RemoveMinLevelFor("db")
+	Named("db").Info("Test")
+}
+
+func (ls *LogSuite) TestToggleDebug(c *C) {
+	l := &Logger{level: ERROR}
+
+	l.ToggleDebugOnSignal()
+
+	c.Assert(signal.Send(os.Getpid(), signal.USR1), IsNil)
+	time.Sleep(50 * time.Millisecond)
+	c.Assert(l.Level(), Equals, DEBUG)
+
+	c.Assert(signal.Send(os.Getpid(), signal.USR1), IsNil)
+	time.Sleep(50 * time.Millisecond)
+	c.Assert(l.Level(), Equals, ERROR)
+}
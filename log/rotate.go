@@ -0,0 +1,201 @@
+package log
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"pkg.re/essentialkaos/ek.v7/signal"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Rotation contains log rotation configuration
+type Rotation struct {
+	MaxSize int64 // Max log file size in bytes (0 - size based rotation disabled)
+	Daily   bool  // Rotate log file every day
+	Keep    int   // Number of compressed archives to keep
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// EnableRotation enable rotation for global logger
+func EnableRotation(rotation Rotation) {
+	Global.EnableRotation(rotation)
+}
+
+// ReopenOnSignal reopen global logger output file on HUP signal
+func ReopenOnSignal() {
+	Global.ReopenOnSignal()
+}
+
+// Rotate rotate global logger output file
+func Rotate() error {
+	return Global.Rotate()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// EnableRotation enable log rotation by size and/or by day. Rotated files
+// are compressed with gzip and saved as file.1.gz, file.2.gz, etc...
+func (l *Logger) EnableRotation(rotation Rotation) {
+	if l == nil {
+		return
+	}
+
+	l.rotation = &rotation
+}
+
+// ReopenOnSignal reopen output file on HUP signal (useful if log is
+// rotated by external tool like logrotate)
+func (l *Logger) ReopenOnSignal() {
+	if l == nil {
+		return
+	}
+
+	signal.Handlers{
+		signal.HUP: func() { l.Reopen() },
+	}.Track()
+}
+
+// Rotate compress current output file, remove oldest archives and
+// open new output file
+func (l *Logger) Rotate() error {
+	if l == nil {
+		return ErrLoggerIsNil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rotate()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// rotate rotate output file (must be called under lock)
+func (l *Logger) rotate() error {
+	if l.fd == nil {
+		return ErrOutputNotSet
+	}
+
+	if l.w != nil {
+		l.w.Flush()
+	}
+
+	l.fd.Close()
+	l.fd = nil
+
+	var keep int
+
+	if l.rotation != nil {
+		keep = l.rotation.Keep
+	}
+
+	rotateErr := rotateFile(l.file, keep)
+
+	// Output file must be opened even if rotation failed
+	err := l.set(l.file, l.perms)
+
+	if rotateErr != nil {
+		return rotateErr
+	}
+
+	return err
+}
+
+// checkRotation rotate output file if it's required (must be called under lock)
+func (l *Logger) checkRotation(size int) {
+	if l.rotation == nil || l.fd == nil {
+		return
+	}
+
+	switch {
+	case l.rotation.MaxSize > 0 && l.size+int64(size) > l.rotation.MaxSize && l.size != 0,
+		l.rotation.Daily && getDate(time.Now()) != l.date:
+		l.rotate()
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// rotateFile shift archives and compress given file to first archive
+func rotateFile(file string, keep int) error {
+	if keep <= 0 {
+		return os.Remove(file)
+	}
+
+	// Remove oldest archives (there can be more than one archive if
+	// number of kept archives was decreased)
+	for i := keep; ; i++ {
+		if os.Remove(getArchiveName(file, i)) != nil {
+			break
+		}
+	}
+
+	for i := keep - 1; i > 0; i-- {
+		os.Rename(getArchiveName(file, i), getArchiveName(file, i+1))
+	}
+
+	err := compressFile(file, getArchiveName(file, 1))
+
+	if err != nil {
+		return err
+	}
+
+	return os.Remove(file)
+}
+
+// compressFile compress file with gzip
+func compressFile(source, target string) error {
+	sfd, err := os.Open(source)
+
+	if err != nil {
+		return err
+	}
+
+	defer sfd.Close()
+
+	info, err := sfd.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	tfd, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode())
+
+	if err != nil {
+		return err
+	}
+
+	defer tfd.Close()
+
+	gw := gzip.NewWriter(tfd)
+
+	_, err = io.Copy(gw, sfd)
+
+	if err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+// getArchiveName return name of archive with given index
+func getArchiveName(file string, index int) string {
+	return fmt.Sprintf("%s.%d.gz", file, index)
+}
+
+// getDate return date as string
+func getDate(t time.Time) string {
+	return t.Format("20060102")
+}
@@ -10,6 +10,7 @@ package mathutil
 
 import (
 	"math"
+	"sort"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -314,6 +315,24 @@ func MaxU64(val1, val2 uint64) uint64 {
 	return val2
 }
 
+// MinF return a smaller value
+func MinF(val1, val2 float64) float64 {
+	if val1 < val2 {
+		return val1
+	}
+
+	return val2
+}
+
+// MaxF return a greater value
+func MaxF(val1, val2 float64) float64 {
+	if val1 > val2 {
+		return val1
+	}
+
+	return val2
+}
+
 // BetweenF return value between min and max values
 func BetweenF(val, min, max float64) float64 {
 	return BetweenF64(val, min, max)
@@ -411,8 +430,13 @@ func AbsF64(val float64) float64 {
 	return val
 }
 
-// Round return rounded value
+// Round return value rounded to given precision (number of digits
+// after the decimal point), halves are rounded away from zero
 func Round(v float64, p int) float64 {
+	if v < 0 {
+		return -Round(-v, p)
+	}
+
 	pow := math.Pow(10, float64(p))
 	digit := pow * v
 	_, div := math.Modf(digit)
@@ -424,4 +448,67 @@ func Round(v float64, p int) float64 {
 	return math.Floor(digit) / pow
 }
 
+// Perc return percentage of current value from total
+func Perc(current, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return current / total * 100.0
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Sum return sum of all values
+func Sum(values []float64) float64 {
+	var result float64
+
+	for _, v := range values {
+		result += v
+	}
+
+	return result
+}
+
+// Mean return arithmetic mean of values
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return Sum(values) / float64(len(values))
+}
+
+// Median return median of values
+func Median(values []float64) float64 {
+	return Percentile(values, 50)
+}
+
+// P95 return 95th percentile of values
+func P95(values []float64) float64 {
+	return Percentile(values, 95)
+}
+
+// Percentile return given percentile (0-100) of values. Value is calculated
+// using linear interpolation between closest ranks. Given slice is
+// not modified.
+func Percentile(values []float64, perc float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := BetweenF64(perc, 0, 100) / 100 * float64(len(sorted)-1)
+	index := int(rank)
+
+	if index+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+
+	return sorted[index] + (sorted[index+1]-sorted[index])*(rank-float64(index))
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -85,6 +85,8 @@ func (s *MathUtilSuite) TestBetween(c *C) {
 }
 
 func (s *MathUtilSuite) TestMinMax(c *C) {
+	c.Assert(MinF(1.5, 2.5), Equals, 1.5)
+	c.Assert(MaxF(1.5, 2.5), Equals, 2.5)
 	c.Assert(Min(1, 10), Equals, 1)
 	c.Assert(Min(-10, 10), Equals, -10)
 	c.Assert(Min(10, -10), Equals, -10)
@@ -176,4 +178,34 @@ func (s *MathUtilSuite) TestRound(c *C) {
 	c.Assert(Round(5.49, 0), Equals, 5.0)
 	c.Assert(Round(5.50, 0), Equals, 6.0)
 	c.Assert(Round(5.51, 0), Equals, 6.0)
+	c.Assert(Round(-5.49, 0), Equals, -5.0)
+	c.Assert(Round(-5.50, 0), Equals, -6.0)
+	c.Assert(Round(3.14159, 2), Equals, 3.14)
+}
+
+func (s *MathUtilSuite) TestPerc(c *C) {
+	c.Assert(Perc(0, 0), Equals, 0.0)
+	c.Assert(Perc(25, 100), Equals, 25.0)
+	c.Assert(Perc(3, 2), Equals, 150.0)
+}
+
+func (s *MathUtilSuite) TestStats(c *C) {
+	values := []float64{5, 1, 4, 2, 3}
+
+	c.Assert(Sum(nil), Equals, 0.0)
+	c.Assert(Sum(values), Equals, 15.0)
+	c.Assert(Mean(nil), Equals, 0.0)
+	c.Assert(Mean(values), Equals, 3.0)
+	c.Assert(Median(nil), Equals, 0.0)
+	c.Assert(Median(values), Equals, 3.0)
+	c.Assert(Median([]float64{4, 1, 3, 2}), Equals, 2.5)
+	c.Assert(Median([]float64{7}), Equals, 7.0)
+	c.Assert(P95(values), Equals, 4.8)
+	c.Assert(Percentile(values, 0), Equals, 1.0)
+	c.Assert(Percentile(values, 100), Equals, 5.0)
+	c.Assert(Percentile(values, 200), Equals, 5.0)
+	c.Assert(Percentile(values, -10), Equals, 1.0)
+
+	// Source slice must not be modified
+	c.Assert(values, DeepEquals, []float64{5, 1, 4, 2, 3})
 }
@@ -9,6 +9,7 @@ package netutil
 
 import (
 	"fmt"
+	"time"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -20,3 +21,21 @@ func ExampleGetIP() {
 		fmt.Printf("Your IP is %s\n", ip)
 	}
 }
+
+func ExampleWaitForPort() {
+	err := WaitForPort("127.0.0.1", 5432, 30*time.Second)
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Println("PostgreSQL is ready")
+}
+
+func ExampleInCIDR() {
+	fmt.Println(InCIDR("192.168.1.10", "10.0.0.0/8", "192.168.0.0/16"))
+
+	// Output:
+	// true
+}
@@ -9,8 +9,17 @@ package netutil
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"fmt"
 	"net"
-	"strings"
+	"strconv"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const (
+	_DIAL_TIMEOUT  = time.Second
+	_WAIT_INTERVAL = 100 * time.Millisecond
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -25,6 +34,73 @@ func GetIP6() string {
 	return getMainIP(true)
 }
 
+// GetAllIPs return all IPv4 addresses of all network interfaces
+// except loopback interfaces
+func GetAllIPs() []string {
+	return getAllIPs(false)
+}
+
+// GetAllIP6s return all IPv6 addresses of all network interfaces
+// except loopback interfaces
+func GetAllIP6s() []string {
+	return getAllIPs(true)
+}
+
+// IsPortFree return true if given TCP port is not used by any process
+func IsPortFree(port int) bool {
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(port))
+
+	if err != nil {
+		return false
+	}
+
+	listener.Close()
+
+	return true
+}
+
+// WaitForPort wait until given TCP port on given host starts accepting
+// connections or timeout is reached
+func WaitForPort(host string, port int, timeout time.Duration) error {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	deadline := time.Now().Add(timeout)
+
+	for {
+		conn, err := net.DialTimeout("tcp", addr, _DIAL_TIMEOUT)
+
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+
+		if time.Now().Add(_WAIT_INTERVAL).After(deadline) {
+			return fmt.Errorf("Port %d on %s is not available after %v", port, host, timeout)
+		}
+
+		time.Sleep(_WAIT_INTERVAL)
+	}
+}
+
+// InCIDR return true if given IP belongs to any of given networks in
+// CIDR notation (e.g. 192.168.1.0/24). Malformed IP or networks are ignored.
+func InCIDR(ip string, networks ...string) bool {
+	addr := net.ParseIP(ip)
+
+	if addr == nil {
+		return false
+	}
+
+	for _, network := range networks {
+		_, ipnet, err := net.ParseCIDR(network)
+
+		if err == nil && ipnet.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 func getMainIP(v6 bool) string {
@@ -44,7 +120,7 @@ func getMainIP(v6 bool) string {
 		for _, a := range addr {
 			ipnet, ok := a.(*net.IPNet)
 
-			if ok && strings.Contains(ipnet.IP.String(), "::") == v6 {
+			if ok && isIP6(ipnet.IP) == v6 {
 				return ipnet.IP.String()
 			}
 		}
@@ -52,3 +128,39 @@ func getMainIP(v6 bool) string {
 
 	return ""
 }
+
+func getAllIPs(v6 bool) []string {
+	var result []string
+
+	interfaces, err := net.Interfaces()
+
+	if err != nil {
+		return nil
+	}
+
+	for _, iface := range interfaces {
+		if iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+
+		addr, err := iface.Addrs()
+
+		if err != nil {
+			continue
+		}
+
+		for _, a := range addr {
+			ipnet, ok := a.(*net.IPNet)
+
+			if ok && isIP6(ipnet.IP) == v6 {
+				result = append(result, ipnet.IP.String())
+			}
+		}
+	}
+
+	return result
+}
+
+func isIP6(ip net.IP) bool {
+	return ip.To4() == nil
+}
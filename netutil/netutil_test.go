@@ -8,7 +8,10 @@ package netutil
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	. "pkg.re/check.v1"
 )
@@ -32,3 +35,45 @@ func (s *NetUtilSuite) TestGetIP(c *C) {
 func (s *NetUtilSuite) TestGetIP6(c *C) {
 	c.Assert(GetIP6(), Not(Equals), "")
 }
+
+func (s *NetUtilSuite) TestGetAllIPs(c *C) {
+	for _, ip := range GetAllIPs() {
+		c.Assert(net.ParseIP(ip).To4(), NotNil)
+		c.Assert(ip, Not(Equals), "127.0.0.1")
+	}
+
+	for _, ip := range GetAllIP6s() {
+		c.Assert(net.ParseIP(ip).To4(), IsNil)
+		c.Assert(ip, Not(Equals), "::1")
+	}
+}
+
+func (s *NetUtilSuite) TestPorts(c *C) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	c.Assert(err, IsNil)
+
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	c.Assert(IsPortFree(port), Equals, false)
+	c.Assert(WaitForPort("127.0.0.1", port, time.Second), IsNil)
+
+	listener.Close()
+
+	c.Assert(IsPortFree(port), Equals, true)
+	c.Assert(
+		WaitForPort("127.0.0.1", port, 200*time.Millisecond),
+		ErrorMatches,
+		fmt.Sprintf("Port %d on 127.0.0.1 is not available after 200ms", port),
+	)
+}
+
+func (s *NetUtilSuite) TestInCIDR(c *C) {
+	c.Assert(InCIDR("192.168.1.10", "192.168.1.0/24"), Equals, true)
+	c.Assert(InCIDR("192.168.2.10", "192.168.1.0/24"), Equals, false)
+	c.Assert(InCIDR("192.168.2.10", "192.168.1.0/24", "192.168.0.0/16"), Equals, true)
+	c.Assert(InCIDR("2001:db8::1", "2001:db8::/32"), Equals, true)
+	c.Assert(InCIDR("10.0.0.1", "2001:db8::/32", "abcd"), Equals, false)
+	c.Assert(InCIDR("abcd", "10.0.0.0/8"), Equals, false)
+	c.Assert(InCIDR("10.0.0.1"), Equals, false)
+}
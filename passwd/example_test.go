@@ -38,3 +38,25 @@ func ExampleGetPasswordStrength() {
 	// Output:
 	// Password is ok
 }
+
+func ExampleGetStrength() {
+	fmt.Println(GetStrength("qwerty123") == STRENGTH_WEAK)
+	fmt.Println(GetStrength("Ab1!Zx4$cv9%") == STRENGTH_STRONG)
+
+	// Output:
+	// true
+	// true
+}
+
+func ExampleHash() {
+	hash, err := Hash("MySuppaPassword")
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Println(Verify("MySuppaPassword", hash))
+
+	// Output: true
+}
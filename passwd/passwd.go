@@ -17,11 +17,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"io"
-	"math/rand"
 	"strings"
-	"time"
+	"unicode"
 
 	"golang.org/x/crypto/bcrypt"
+
+	"pkg.re/essentialkaos/ek.v7/rand"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -39,6 +40,30 @@ const (
 	_SYMBOLS_STRONG = "!\";%:?*()_+=-~/\\<>,.[]{}"
 )
 
+const (
+	_MIN_LENGTH_MEDIUM = 8
+	_MIN_LENGTH_STRONG = 12
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// commonPasswords contains the most popular passwords
+var commonPasswords = map[string]bool{
+	"123456": true, "1234567": true, "12345678": true, "123456789": true,
+	"1234567890": true, "111111": true, "000000": true, "123123": true,
+	"121212": true, "654321": true, "666666": true, "696969": true,
+	"password": true, "passw0rd": true, "p@ssw0rd": true, "qwerty": true,
+	"qwertyuiop": true, "asdfgh": true, "zxcvbnm": true, "1q2w3e4r": true,
+	"1qaz2wsx": true, "abc123": true, "letmein": true, "welcome": true,
+	"monkey": true, "dragon": true, "master": true, "shadow": true,
+	"sunshine": true, "princess": true, "football": true, "baseball": true,
+	"superman": true, "batman": true, "trustno1": true, "iloveyou": true,
+	"starwars": true, "whatever": true, "freedom": true, "michael": true,
+	"charlie": true, "jennifer": true, "jordan": true, "hunter": true,
+	"access": true, "secret": true, "admin": true, "root": true,
+	"login": true, "changeme": true, "default": true, "test": true,
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // Encrypt hash and encrypt password with salt and pepper
@@ -132,12 +157,78 @@ func Check(password, pepper, hash string) bool {
 	return bcrypt.CompareHashAndPassword(h, hasher.Sum(nil)) == nil
 }
 
-// GenPassword generate random password
+// GenPassword generate random password with at least given strength
 func GenPassword(length, strength int) string {
 	return getRandomPassword(length, between(strength, 0, 2))
 }
 
-// GetPasswordStrength return password strength
+// Hash generate bcrypt hash for given password with given cost (10 by default).
+// Note that bcrypt uses only first 72 bytes of password.
+func Hash(password string, cost ...int) (string, error) {
+	if password == "" {
+		return "", errors.New("Password can't be empty")
+	}
+
+	hashCost := bcrypt.DefaultCost
+
+	if len(cost) != 0 {
+		hashCost = cost[0]
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), hashCost)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+// Verify compare password with bcrypt hash
+func Verify(password, hash string) bool {
+	if password == "" || hash == "" {
+		return false
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// GetStrength return password strength based on password length, number of
+// used symbol classes (lowercase and uppercase letters, digits and special
+// symbols) and presence of password in list of common passwords
+func GetStrength(password string) int {
+	if password == "" || isCommonPassword(password) {
+		return STRENGTH_WEAK
+	}
+
+	var score int
+
+	for _, size := range []int{8, 12, 16} {
+		if len(password) >= size {
+			score++
+		}
+	}
+
+	score += getClassesNum(password)
+
+	if strings.Count(password, password[:1]) == len(password) {
+		score = 0
+	}
+
+	switch {
+	case score >= 6:
+		return STRENGTH_STRONG
+	case score >= 4:
+		return STRENGTH_MEDIUM
+	default:
+		return STRENGTH_WEAK
+	}
+}
+
+// GetPasswordStrength return password strength based on used symbol classes
+// and password length
+//
+// Deprecated: Use GetStrength instead
 func GetPasswordStrength(password string) int {
 	if password == "" {
 		return STRENGTH_WEAK
@@ -226,8 +317,11 @@ func getRandomPassword(length, strength int) string {
 		return ""
 	}
 
-	if strength == STRENGTH_STRONG && length < 6 {
-		length = 6
+	switch {
+	case strength == STRENGTH_MEDIUM && length < _MIN_LENGTH_MEDIUM:
+		length = _MIN_LENGTH_MEDIUM
+	case strength == STRENGTH_STRONG && length < _MIN_LENGTH_STRONG:
+		length = _MIN_LENGTH_STRONG
 	}
 
 	var symbols = _SYMBOLS_WEAK
@@ -240,19 +334,48 @@ func getRandomPassword(length, strength int) string {
 	}
 
 	for {
-		ls := len(symbols)
-		r := make([]byte, length)
-
-		rand.Seed(time.Now().UTC().UnixNano())
+		r := rand.StringFrom(length, symbols)
 
-		for i := 0; i < length; i++ {
-			r[i] = symbols[rand.Intn(ls)]
+		if GetStrength(r) >= strength {
+			return r
 		}
+	}
+}
+
+// isCommonPassword return true if password (or password without suffix with
+// digits and special symbols) is in list of common passwords
+func isCommonPassword(password string) bool {
+	password = strings.ToLower(password)
 
-		if GetPasswordStrength(string(r)) == strength {
-			return string(r)
+	if commonPasswords[password] {
+		return true
+	}
+
+	base := strings.TrimRightFunc(password, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	return base != "" && commonPasswords[base]
+}
+
+// getClassesNum return number of symbol classes used in password
+func getClassesNum(password string) int {
+	var lower, upper, digit, special int
+
+	for _, r := range password {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = 1
+		case r >= 'A' && r <= 'Z':
+			upper = 1
+		case r >= '0' && r <= '9':
+			digit = 1
+		default:
+			special = 1
 		}
 	}
+
+	return lower + upper + digit + special
 }
 
 func isValidPepper(pepper string) bool {
@@ -40,16 +40,49 @@ func (s *PasswdSuite) TestStrengthCheck(c *C) {
 	c.Assert(GetPasswordStrength(""), Equals, STRENGTH_WEAK)
 }
 
+func (s *PasswdSuite) TestGetStrength(c *C) {
+	c.Assert(GetStrength(""), Equals, STRENGTH_WEAK)
+	c.Assert(GetStrength("Test123"), Equals, STRENGTH_WEAK)
+	c.Assert(GetStrength("aaaaaaaaaaaaaaaaaaaa"), Equals, STRENGTH_WEAK)
+	c.Assert(GetStrength("Password123!"), Equals, STRENGTH_WEAK)
+	c.Assert(GetStrength("QWERTY"), Equals, STRENGTH_WEAK)
+	c.Assert(GetStrength("secret1234%$"), Equals, STRENGTH_WEAK)
+	c.Assert(GetStrength("mango1234%$q"), Equals, STRENGTH_MEDIUM)
+	c.Assert(GetStrength("Ab1!zxcv"), Equals, STRENGTH_MEDIUM)
+	c.Assert(GetStrength("Ab1!Zx4$cv9%"), Equals, STRENGTH_STRONG)
+	c.Assert(GetStrength("correcthorsebatterystaple"), Equals, STRENGTH_MEDIUM)
+}
+
+func (s *PasswdSuite) TestHash(c *C) {
+	hash, err := Hash("Test123", 4)
+
+	c.Assert(err, IsNil)
+	c.Assert(hash, Not(Equals), "")
+
+	c.Assert(Verify("Test123", hash), Equals, true)
+	c.Assert(Verify("Test1234", hash), Equals, false)
+	c.Assert(Verify("", hash), Equals, false)
+	c.Assert(Verify("Test123", ""), Equals, false)
+	c.Assert(Verify("Test123", "abcd"), Equals, false)
+
+	_, err = Hash("")
+
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "Password can't be empty")
+}
+
 func (s *PasswdSuite) TestGenPassword(c *C) {
 	c.Assert(GenPassword(0, STRENGTH_WEAK), Equals, "")
 	c.Assert(GenPassword(16, STRENGTH_WEAK), HasLen, 16)
-	c.Assert(GetPasswordStrength(GenPassword(16, STRENGTH_WEAK)), Equals, STRENGTH_WEAK)
-	c.Assert(GetPasswordStrength(GenPassword(16, STRENGTH_MEDIUM)), Equals, STRENGTH_MEDIUM)
-	c.Assert(GetPasswordStrength(GenPassword(16, STRENGTH_STRONG)), Equals, STRENGTH_STRONG)
-	c.Assert(GetPasswordStrength(GenPassword(4, STRENGTH_STRONG)), Equals, STRENGTH_STRONG)
-
-	c.Assert(GetPasswordStrength(GenPassword(16, -100)), Equals, STRENGTH_WEAK)
-	c.Assert(GetPasswordStrength(GenPassword(4, 100)), Equals, STRENGTH_STRONG)
+	c.Assert(GenPassword(4, STRENGTH_MEDIUM), HasLen, 8)
+	c.Assert(GenPassword(4, STRENGTH_STRONG), HasLen, 12)
+	c.Assert(GenPassword(16, -100), Matches, "[a-z]{16}")
+	c.Assert(GetStrength(GenPassword(16, STRENGTH_WEAK)) >= STRENGTH_WEAK, Equals, true)
+	c.Assert(GetStrength(GenPassword(8, STRENGTH_MEDIUM)) >= STRENGTH_MEDIUM, Equals, true)
+	c.Assert(GetStrength(GenPassword(16, STRENGTH_MEDIUM)) >= STRENGTH_MEDIUM, Equals, true)
+	c.Assert(GetStrength(GenPassword(16, STRENGTH_STRONG)), Equals, STRENGTH_STRONG)
+	c.Assert(GetStrength(GenPassword(4, STRENGTH_STRONG)), Equals, STRENGTH_STRONG)
+	c.Assert(GetStrength(GenPassword(4, 100)), Equals, STRENGTH_STRONG)
 }
 
 func (s *PasswdSuite) TestEncrypt(c *C) {
@@ -26,7 +26,14 @@ var Dir = "/var/run"
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// Create create file with process pid file
+// _PID_FILE_PERMS is permissions of created pid files
+const _PID_FILE_PERMS = 0644
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Create create file with process pid file. If pid file already exist and
+// contains pid of working process, error will be returned. Stale pid
+// files are overwritten.
 func Create(name string) error {
 	err := checkPidDir(Dir)
 
@@ -38,17 +45,34 @@ func Create(name string) error {
 		return errors.New("Pid file name can't be blank")
 	}
 
-	pidFile := Dir + "/" + normalizePidFilename(name)
+	pidFile := getPidFilePath(name)
 
 	if fsutil.IsExist(pidFile) {
+		pid := Get(name)
+
+		if pid != os.Getpid() && IsWorks(name) {
+			return fmt.Errorf("Process with pid %d from pid file %s is still works", pid, pidFile)
+		}
+
 		os.Remove(pidFile)
 	}
 
-	return ioutil.WriteFile(
-		pidFile,
-		[]byte(fmt.Sprintf("%d\n", os.Getpid())),
-		0644,
-	)
+	// O_EXCL is used for preventing writing pid to file
+	// by symlink created by someone else
+	fd, err := os.OpenFile(pidFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, _PID_FILE_PERMS)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(fd, "%d\n", os.Getpid())
+
+	if err != nil {
+		fd.Close()
+		return err
+	}
+
+	return fd.Close()
 }
 
 // Remove remove file with process pid file
@@ -59,9 +83,7 @@ func Remove(name string) error {
 		return err
 	}
 
-	pidFile := Dir + "/" + normalizePidFilename(name)
-
-	return os.Remove(pidFile)
+	return os.Remove(getPidFilePath(name))
 }
 
 // Get return pid from pid file
@@ -72,8 +94,7 @@ func Get(name string) int {
 		return -1
 	}
 
-	pidFile := Dir + "/" + normalizePidFilename(name)
-	data, err := ioutil.ReadFile(pidFile)
+	data, err := ioutil.ReadFile(getPidFilePath(name))
 
 	if err != nil {
 		return -1
@@ -81,7 +102,7 @@ func Get(name string) int {
 
 	pid, err := strconv.Atoi(strings.TrimRight(string(data[:]), "\n"))
 
-	if err != nil {
+	if err != nil || pid <= 0 {
 		return -1
 	}
 
@@ -106,6 +127,11 @@ func checkPidDir(path string) error {
 	return nil
 }
 
+// getPidFilePath return full path to pid file
+func getPidFilePath(name string) string {
+	return Dir + "/" + normalizePidFilename(name)
+}
+
 // normalizePidFilename return pidfile name with extension
 func normalizePidFilename(name string) string {
 	if !strings.Contains(name, ".pid") {
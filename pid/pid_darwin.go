@@ -7,7 +7,23 @@ package pid
 //                                                                                    //
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// IsWorks return if process pid is not -1
+import (
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsWorks return if process with pid from pid file is works
 func IsWorks(name string) bool {
-	return Get(name) != -1
+	pid := Get(name)
+
+	if pid == -1 {
+		return false
+	}
+
+	// Signal 0 is used only for checking process existence, EPERM
+	// means that process exist but owned by other user
+	err := syscall.Kill(pid, syscall.Signal(0))
+
+	return err == nil || err == syscall.EPERM
 }
@@ -31,5 +31,5 @@ func (s *PidSuite) TestIsWorks(c *C) {
 	// Write fake pid to pid file
 	ioutil.WriteFile(s.Dir+"/test.pid", []byte("9736163"), 0644)
 
-	c.Assert(IsWorks("test"), Equals, true)
+	c.Assert(IsWorks("test"), Equals, false)
 }
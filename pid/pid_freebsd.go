@@ -0,0 +1,29 @@
+package pid
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsWorks return if process with pid from pid file is works
+func IsWorks(name string) bool {
+	pid := Get(name)
+
+	if pid == -1 {
+		return false
+	}
+
+	// Signal 0 is used only for checking process existence, EPERM
+	// means that process exist but owned by other user
+	err := syscall.Kill(pid, syscall.Signal(0))
+
+	return err == nil || err == syscall.EPERM
+}
@@ -9,13 +9,25 @@ package pid
 
 import (
 	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
 
 	"pkg.re/essentialkaos/ek.v7/fsutil"
+	"pkg.re/essentialkaos/ek.v7/system"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// IsWorks return if process with pid from pid file is works
+// _CLOCK_TICKS is number of clock ticks per second (USER_HZ)
+const _CLOCK_TICKS = 100
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsWorks return if process with pid from pid file is works. If process
+// was started after pid file creation, pid was reused by other process and
+// pid file is considered as stale.
 func IsWorks(name string) bool {
 	pid := Get(name)
 
@@ -23,5 +35,59 @@ func IsWorks(name string) bool {
 		return false
 	}
 
-	return fsutil.IsExist(fmt.Sprintf("/proc/%d", pid))
+	if !fsutil.IsExist(fmt.Sprintf("/proc/%d", pid)) {
+		return false
+	}
+
+	mtime, err := fsutil.GetMTime(getPidFilePath(name))
+
+	if err != nil {
+		return false
+	}
+
+	start, err := getProcessStartTime(pid)
+
+	// If we can't get process start time, we rely only on process existence
+	if err != nil {
+		return true
+	}
+
+	// Boot time has seconds precision, so we add one second to mtime
+	return !start.After(mtime.Add(time.Second))
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getProcessStartTime return process start time
+func getProcessStartTime(pid int) (time.Time, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Process name can contain spaces, so we skip it
+	stat := string(data)
+	stat = stat[strings.LastIndex(stat, ")")+1:]
+
+	// Start time is 20th field after process name
+	fields := strings.Fields(stat)
+
+	if len(fields) < 20 {
+		return time.Time{}, fmt.Errorf("Can't parse stat file for process %d", pid)
+	}
+
+	ticks, err := strconv.ParseInt(fields[19], 10, 64)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Can't parse stat file for process %d", pid)
+	}
+
+	boot, err := system.GetBootTime()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return boot.Add(time.Duration(ticks) * time.Second / _CLOCK_TICKS), nil
 }
@@ -9,6 +9,8 @@ package pid
 
 import (
 	"io/ioutil"
+	"os"
+	"time"
 
 	. "pkg.re/check.v1"
 )
@@ -32,4 +34,23 @@ func (s *PidSuite) TestIsWorks(c *C) {
 	ioutil.WriteFile(s.Dir+"/test.pid", []byte("9736163"), 0644)
 
 	c.Assert(IsWorks("test"), Equals, false)
+
+	// Pid file created before init process start (pid was reused)
+	ioutil.WriteFile(s.Dir+"/test.pid", []byte("1"), 0644)
+	os.Chtimes(s.Dir+"/test.pid", time.Unix(0, 0), time.Unix(0, 0))
+
+	c.Assert(IsWorks("test"), Equals, false)
+
+	ioutil.WriteFile(s.Dir+"/test.pid", []byte("1"), 0644)
+
+	c.Assert(IsWorks("test"), Equals, true)
+	c.Assert(Create("test"), ErrorMatches, "Process with pid 1 from pid file .* is still works")
+
+	// Stale pid file must be overwritten
+	os.Chtimes(s.Dir+"/test.pid", time.Unix(0, 0), time.Unix(0, 0))
+
+	c.Assert(Create("test"), IsNil)
+	c.Assert(Get("test"), Equals, os.Getpid())
+
+	Remove("test")
 }
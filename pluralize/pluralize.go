@@ -32,7 +32,7 @@ func PluralizeSpecial(p Pluralizer, n int, data ...string) string {
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 func safeSliceGet(data []string, index int) string {
-	if len(data) < index {
+	if index >= len(data) {
 		return ""
 	}
 
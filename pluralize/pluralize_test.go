@@ -32,6 +32,47 @@ func (s *PluralizeSuite) TestAf(c *C) {
 	c.Assert(PluralizeSpecial(Af, 2, data...), Equals, "2 B")
 }
 
+func (s *PluralizeSuite) TestP(c *C) {
+	c.Assert(Pluralize(1), Equals, "1 ")
+	c.Assert(P("%d file%s", 1), Equals, "1 file")
+	c.Assert(P("%d file%s", 3), Equals, "3 files")
+	c.Assert(P("%d %s found", 1, "error"), Equals, "1 error found")
+	c.Assert(P("%d %s found", 0, "error"), Equals, "0 errors found")
+	c.Assert(P("%s: %d", 2, "Box"), Equals, "Boxes: 2")
+	c.Assert(P("%d %s", 5, "goose", "geese"), Equals, "5 geese")
+	c.Assert(PS(Ru, "%d %s", 5, "файл", "файла", "файлов"), Equals, "5 файлов")
+	c.Assert(PS(Ru, "%d %s", 5, "файл", "файла"), Equals, "5 ")
+}
+
+func (s *PluralizeSuite) TestPlural(c *C) {
+	c.Assert(Plural(""), Equals, "")
+	c.Assert(Plural("file"), Equals, "files")
+	c.Assert(Plural("process"), Equals, "processes")
+	c.Assert(Plural("match"), Equals, "matches")
+	c.Assert(Plural("entry"), Equals, "entries")
+	c.Assert(Plural("day"), Equals, "days")
+	c.Assert(Plural("knife"), Equals, "knives")
+	c.Assert(Plural("wife"), Equals, "wives")
+	c.Assert(Plural("life"), Equals, "lives")
+	c.Assert(Plural("half"), Equals, "halves")
+	c.Assert(Plural("self"), Equals, "selves")
+	c.Assert(Plural("shelf"), Equals, "shelves")
+	c.Assert(Plural("safe"), Equals, "safes")
+	c.Assert(Plural("cafe"), Equals, "cafes")
+	c.Assert(Plural("golf"), Equals, "golfs")
+	c.Assert(Plural("Child"), Equals, "Children")
+	c.Assert(Plural("person"), Equals, "people")
+	c.Assert(Plural("sheep"), Equals, "sheep")
+
+	RegisterRule("is", "es")
+	RegisterIrregular("cactus", "cacti")
+	RegisterUncountable("equipment")
+
+	c.Assert(Plural("analysis"), Equals, "analyses")
+	c.Assert(Plural("cactus"), Equals, "cacti")
+	c.Assert(Plural("equipment"), Equals, "equipment")
+}
+
 func (s *PluralizeSuite) TestAch(c *C) {
 	data := []string{"A", "B"}
 
@@ -0,0 +1,142 @@
+package pluralize
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// rules contains suffix replacement rules for english words
+var rules = map[string]string{
+	"s":  "ses",
+	"x":  "xes",
+	"z":  "zes",
+	"ch": "ches",
+	"sh": "shes",
+	"y":  "ies",
+	"ay": "ays",
+	"ey": "eys",
+	"oy": "oys",
+	"uy": "uys",
+}
+
+// irregulars contains irregular english words
+var irregulars = map[string]string{
+	"child":  "children",
+	"foot":   "feet",
+	"goose":  "geese",
+	"half":   "halves",
+	"knife":  "knives",
+	"life":   "lives",
+	"man":    "men",
+	"mouse":  "mice",
+	"person": "people",
+	"self":   "selves",
+	"shelf":  "shelves",
+	"tooth":  "teeth",
+	"wife":   "wives",
+	"wolf":   "wolves",
+	"woman":  "women",
+}
+
+// uncountables contains english words which have no plural form
+var uncountables = map[string]bool{
+	"data":        true,
+	"fish":        true,
+	"information": true,
+	"series":      true,
+	"sheep":       true,
+	"species":     true,
+}
+
+// rulesMu protects rules maps
+var rulesMu sync.RWMutex
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// P pluralize message using default pluralizer. In format %d is replaced by
+// number and %s by word form. If forms are not set, %s is replaced by plural
+// suffix ("" or "s"). If only one form (singular) is set, plural form is
+// generated by english rules.
+//
+//	P("%d file%s", 3)            // 3 files
+//	P("%d %s found", 1, "error") // 1 error found
+//	P("%d %s", 5, "child")       // 5 children
+func P(format string, n int, forms ...string) string {
+	return PS(DefaultPluralizer, format, n, forms...)
+}
+
+// PS pluralize message using given pluralizer (see P for details)
+func PS(p Pluralizer, format string, n int, forms ...string) string {
+	switch len(forms) {
+	case 0:
+		forms = []string{"", "s"}
+	case 1:
+		forms = []string{forms[0], Plural(forms[0])}
+	}
+
+	return strings.NewReplacer(
+		"%d", strconv.Itoa(n),
+		"%s", safeSliceGet(forms, p(n)),
+	).Replace(format)
+}
+
+// Plural return plural form of given english word
+func Plural(word string) string {
+	if word == "" {
+		return ""
+	}
+
+	lword := strings.ToLower(word)
+
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	if uncountables[lword] {
+		return word
+	}
+
+	if plural, ok := irregulars[lword]; ok {
+		return word[:1] + plural[1:]
+	}
+
+	// Use rule with longest matching suffix
+	for i := 0; i < len(lword); i++ {
+		if replacement, ok := rules[lword[i:]]; ok {
+			return word[:i] + replacement
+		}
+	}
+
+	return word + "s"
+}
+
+// RegisterRule add rule for replacing suffix of words in plural form
+// (e.g. "is" → "es" for "analysis" → "analyses")
+func RegisterRule(suffix, replacement string) {
+	rulesMu.Lock()
+	rules[strings.ToLower(suffix)] = replacement
+	rulesMu.Unlock()
+}
+
+// RegisterIrregular add irregular word
+func RegisterIrregular(singular, plural string) {
+	rulesMu.Lock()
+	irregulars[strings.ToLower(singular)] = strings.ToLower(plural)
+	rulesMu.Unlock()
+}
+
+// RegisterUncountable add word which have no plural form
+func RegisterUncountable(word string) {
+	rulesMu.Lock()
+	uncountables[strings.ToLower(word)] = true
+	rulesMu.Unlock()
+}
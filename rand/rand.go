@@ -9,38 +9,104 @@ package rand
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	crand "crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"math/big"
 	"math/rand"
-	"time"
+	"sync"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-var symbols = "QWERTYUIOPASDFGHJKLZXCVBNMqwertyuiopasdfghjklzxcvbnm1234567890"
+// Symbol classes used for password generation
+const (
+	CLASS_LOWER   = 1 << iota // Lowercase letters
+	CLASS_UPPER               // Uppercase letters
+	CLASS_DIGITS              // Digits
+	CLASS_SYMBOLS             // Special symbols
+
+	CLASS_ALL = CLASS_LOWER | CLASS_UPPER | CLASS_DIGITS | CLASS_SYMBOLS
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const (
+	_SYMBOLS_LOWER   = "qwertyuiopasdfghjklzxcvbnm"
+	_SYMBOLS_UPPER   = "QWERTYUIOPASDFGHJKLZXCVBNM"
+	_SYMBOLS_DIGITS  = "1234567890"
+	_SYMBOLS_SPECIAL = "!@#$%^&*()_-+=[]{}<>,.?/|~"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var symbols = _SYMBOLS_UPPER + _SYMBOLS_LOWER + _SYMBOLS_DIGITS
+
+// source is source of random data
+var source io.Reader = crand.Reader
+
+// sourceMu protects source (math/rand generator is not thread-safe)
+var sourceMu sync.Mutex
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Seed enable deterministic mode, so all methods will return the same
+// sequence of data for the same seed. This mode is NOT secure and should
+// be used only in tests.
+func Seed(seed int64) {
+	sourceMu.Lock()
+	source = rand.New(rand.NewSource(seed))
+	sourceMu.Unlock()
+}
+
+// Secure disable deterministic mode and restore cryptographically
+// secure source of random data
+func Secure() {
+	sourceMu.Lock()
+	source = crand.Reader
+	sourceMu.Unlock()
+}
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // String return string with random chars
 func String(length int) string {
-	if length <= 0 {
+	return StringFrom(length, symbols)
+}
+
+// StringFrom return string with random chars from given charset
+func StringFrom(length int, charset string) string {
+	if length <= 0 || charset == "" {
 		return ""
 	}
 
-	symbolsLength := len(symbols)
-	result := make([]byte, length)
-
-	rand.Seed(time.Now().UTC().UnixNano())
+	chars := []rune(charset)
+	result := make([]rune, length)
 
 	for i := 0; i < length; i++ {
-		result[i] = symbols[rand.Intn(symbolsLength)]
+		result[i] = chars[Int(len(chars))]
 	}
 
 	return string(result)
 }
 
-// Int return random int
+// Int return random int in range [0, n). Int panics if source of random
+// data is not available.
 func Int(n int) int {
-	rand.Seed(time.Now().UTC().UnixNano())
-	return rand.Intn(n)
+	if n <= 0 {
+		return 0
+	}
+
+	sourceMu.Lock()
+	v, err := crand.Int(source, big.NewInt(int64(n)))
+	sourceMu.Unlock()
+
+	if err != nil {
+		panic("Can't read random data: " + err.Error())
+	}
+
+	return int(v.Int64())
 }
 
 // Slice return slice with random chars
@@ -53,10 +119,99 @@ func Slice(length int) []string {
 	result := make([]string, length)
 
 	for i := 0; i < length; i++ {
-		result[i] = string(symbols[rand.Intn(symbolsLength)])
+		result[i] = string(symbols[Int(symbolsLength)])
+	}
+
+	return result
+}
+
+// Bytes return slice with given number of random bytes. Bytes panics if
+// source of random data is not available.
+func Bytes(size int) []byte {
+	if size <= 0 {
+		return []byte{}
+	}
+
+	result := make([]byte, size)
+
+	sourceMu.Lock()
+	_, err := io.ReadFull(source, result)
+	sourceMu.Unlock()
+
+	if err != nil {
+		panic("Can't read random data: " + err.Error())
 	}
 
 	return result
 }
 
+// Hex return given number of random bytes encoded as hex string (so
+// string length is twice bigger than number of bytes)
+func Hex(size int) string {
+	return hex.EncodeToString(Bytes(size))
+}
+
+// Base64 return given number of random bytes encoded as URL-safe base64
+// string without padding (useful for tokens)
+func Base64(size int) string {
+	return base64.RawURLEncoding.EncodeToString(Bytes(size))
+}
+
+// Password return random password with symbols of given classes. Password
+// contains at least one symbol of every class (if length is enough).
+func Password(length, classes int) string {
+	if length <= 0 {
+		return ""
+	}
+
+	var charsets []string
+
+	for _, class := range []int{CLASS_LOWER, CLASS_UPPER, CLASS_DIGITS, CLASS_SYMBOLS} {
+		if classes&class != 0 {
+			charsets = append(charsets, getClassSymbols(class))
+		}
+	}
+
+	if len(charsets) == 0 {
+		charsets = []string{getClassSymbols(CLASS_LOWER)}
+	}
+
+	var all string
+	var result []byte
+
+	for _, charset := range charsets {
+		all += charset
+
+		if len(result) < length {
+			result = append(result, charset[Int(len(charset))])
+		}
+	}
+
+	for len(result) < length {
+		result = append(result, all[Int(len(all))])
+	}
+
+	// Shuffle symbols, so required symbols are not always at beginning
+	for i := len(result) - 1; i > 0; i-- {
+		j := Int(i + 1)
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return string(result)
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
+
+// getClassSymbols return symbols for given class
+func getClassSymbols(class int) string {
+	switch class {
+	case CLASS_UPPER:
+		return _SYMBOLS_UPPER
+	case CLASS_DIGITS:
+		return _SYMBOLS_DIGITS
+	case CLASS_SYMBOLS:
+		return _SYMBOLS_SPECIAL
+	}
+
+	return _SYMBOLS_LOWER
+}
@@ -51,3 +51,65 @@ func (s *RandSuite) TestSlice(c *C) {
 	c.Assert(t1, Not(Equals), t2)
 	c.Assert(Slice(0), HasLen, 0)
 }
+
+func (s *RandSuite) TestStringFrom(c *C) {
+	c.Assert(StringFrom(10, ""), Equals, "")
+	c.Assert(StringFrom(0, "abc"), Equals, "")
+	c.Assert(StringFrom(10, "a"), Equals, "aaaaaaaaaa")
+	c.Assert(strings.Trim(StringFrom(100, "ab✶"), "ab✶"), Equals, "")
+	c.Assert([]rune(StringFrom(100, "ab✶")), HasLen, 100)
+}
+
+func (s *RandSuite) TestBytes(c *C) {
+	c.Assert(Bytes(0), HasLen, 0)
+	c.Assert(Bytes(16), HasLen, 16)
+	c.Assert(Hex(16), HasLen, 32)
+	c.Assert(Hex(16), Matches, "[0-9a-f]{32}")
+	c.Assert(Base64(32), HasLen, 43)
+	c.Assert(Base64(32), Matches, "[0-9a-zA-Z_-]{43}")
+	c.Assert(Hex(16), Not(Equals), Hex(16))
+}
+
+func (s *RandSuite) TestPassword(c *C) {
+	c.Assert(Password(0, CLASS_ALL), Equals, "")
+	c.Assert(Password(16, 0), Matches, "[a-z]{16}")
+	c.Assert(Password(16, CLASS_DIGITS), Matches, "[0-9]{16}")
+	c.Assert(Password(2, CLASS_ALL), HasLen, 2)
+
+	for i := 0; i < 100; i++ {
+		p := Password(8, CLASS_ALL)
+
+		c.Assert(p, HasLen, 8)
+		c.Assert(strings.ContainsAny(p, _SYMBOLS_LOWER), Equals, true)
+		c.Assert(strings.ContainsAny(p, _SYMBOLS_UPPER), Equals, true)
+		c.Assert(strings.ContainsAny(p, _SYMBOLS_DIGITS), Equals, true)
+		c.Assert(strings.ContainsAny(p, _SYMBOLS_SPECIAL), Equals, true)
+	}
+}
+
+func (s *RandSuite) TestSeed(c *C) {
+	defer Secure()
+
+	Seed(42)
+	s1, p1, h1 := String(32), Password(16, CLASS_ALL), Hex(8)
+
+	Seed(42)
+	s2, p2, h2 := String(32), Password(16, CLASS_ALL), Hex(8)
+
+	c.Assert(s1, Equals, s2)
+	c.Assert(p1, Equals, p2)
+	c.Assert(h1, Equals, h2)
+
+	Secure()
+
+	c.Assert(String(32), Not(Equals), s1)
+}
+
+func (s *RandSuite) TestBrokenSource(c *C) {
+	defer Secure()
+
+	source = strings.NewReader("")
+
+	c.Assert(func() { Bytes(8) }, PanicMatches, "Can't read random data: .*")
+	c.Assert(func() { Int(10) }, PanicMatches, "Can't read random data: .*")
+}
@@ -45,3 +45,25 @@ func ExampleHandlers_TrackAsync() {
 
 	time.Sleep(time.Hour)
 }
+
+func ExampleHandlers_TrackOnce() {
+	termHandler := func() {
+		fmt.Println("Got TERM signal")
+	}
+
+	// Handler will be executed only for first received signal
+	Handlers{
+		TERM: termHandler,
+	}.TrackOnce()
+}
+
+func ExampleGracefulShutdown() {
+	// Handlers will be executed one by one on TERM, INT or QUIT signal,
+	// and if all handlers are finished in 5 seconds, process will exit with
+	// code 0 (e.g. here you can flush log data and remove pid file)
+	GracefulShutdown(
+		5*time.Second,
+		func() { fmt.Println("Flush log data") },
+		func() { fmt.Println("Remove pid file") },
+	)
+}
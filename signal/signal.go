@@ -14,6 +14,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -59,6 +60,15 @@ type Handlers map[os.Signal]func()
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// _QUEUE_SIZE is max number of signals which can be queued while
+// handler is executed
+const _QUEUE_SIZE = 32
+
+// exitFunc is function used for exit after graceful shutdown
+var exitFunc = os.Exit
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // Send send given signal to process
 func Send(pid int, signal syscall.Signal) error {
 	return syscall.Kill(pid, signal)
@@ -66,43 +76,95 @@ func Send(pid int, signal syscall.Signal) error {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// Track catch signal and execute handler for this signal
+// Track catch signal and execute handler for this signal. Signals received
+// while handler is executed are queued and handled after it.
 func (h Handlers) Track() {
-	c := make(chan os.Signal)
+	h.track(false, false)
+}
 
-	for s := range h {
-		signal.Notify(c, s)
-	}
+// TrackAsync catch signal and execute async handler for this signal
+func (h Handlers) TrackAsync() {
+	h.track(true, false)
+}
+
+// TrackOnce catch signal and execute handler for this signal only once,
+// after that tracking of all given signals is stopped. Signals which are
+// not tracked by other handlers are restored to default behavior.
+func (h Handlers) TrackOnce() {
+	h.track(false, true)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GracefulShutdown catch TERM, INT and QUIT signals, execute given handlers
+// (e.g. flush log data, remove pid file) one by one and exit with code 0. If
+// handlers aren't finished in given time or signal is received one more time,
+// process will be terminated with code 1.
+func GracefulShutdown(timeout time.Duration, handlers ...func()) {
+	c := make(chan os.Signal, _QUEUE_SIZE)
+
+	signal.Notify(c, TERM, INT, QUIT)
 
 	go func() {
-		for {
-			sig := <-c
+		<-c
 
-			handler := h[sig]
+		done := make(chan bool)
 
-			if handler != nil {
-				handler()
+		go func() {
+			for _, handler := range handlers {
+				if handler != nil {
+					handler()
+				}
 			}
+
+			close(done)
+		}()
+
+		var timer <-chan time.Time
+
+		if timeout > 0 {
+			timer = time.After(timeout)
+		}
+
+		select {
+		case <-done:
+			exitFunc(0)
+		case <-timer:
+			exitFunc(1)
+		case <-c:
+			exitFunc(1)
 		}
 	}()
 }
 
-// TrackAsync catch signal and execute async handler for this signal
-func (h Handlers) TrackAsync() {
-	c := make(chan os.Signal)
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// track start signals tracking
+func (h Handlers) track(async, once bool) {
+	c := make(chan os.Signal, _QUEUE_SIZE)
 
 	for s := range h {
 		signal.Notify(c, s)
 	}
 
 	go func() {
-		for {
-			sig := <-c
+		for sig := range c {
+			if once {
+				signal.Stop(c)
+			}
 
 			handler := h[sig]
 
 			if handler != nil {
-				go handler()
+				if async {
+					go handler()
+				} else {
+					handler()
+				}
+			}
+
+			if once {
+				return
 			}
 		}
 	}()
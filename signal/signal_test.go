@@ -0,0 +1,150 @@
+// +build !windows
+
+package signal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os"
+	"os/signal"
+	"testing"
+	"time"
+
+	. "pkg.re/check.v1"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func Test(t *testing.T) { TestingT(t) }
+
+type SignalSuite struct{}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var _ = Suite(&SignalSuite{})
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func (s *SignalSuite) TestQueueing(c *C) {
+	calls := make(chan bool, _QUEUE_SIZE)
+	release := make(chan bool)
+
+	Handlers{
+		USR1: func() {
+			calls <- true
+			<-release
+		},
+	}.Track()
+
+	c.Assert(Send(os.Getpid(), USR1), IsNil)
+	c.Assert(waitFor(calls), Equals, true)
+
+	// Second signal is received while handler is still executed
+	c.Assert(Send(os.Getpid(), USR1), IsNil)
+
+	time.Sleep(50 * time.Millisecond)
+
+	c.Assert(calls, HasLen, 0)
+
+	release <- true
+
+	c.Assert(waitFor(calls), Equals, true)
+
+	release <- true
+}
+
+func (s *SignalSuite) TestOnce(c *C) {
+	calls := make(chan bool, _QUEUE_SIZE)
+	guard := make(chan os.Signal, _QUEUE_SIZE)
+
+	// Keep process alive after tracking of USR2 is stopped
+	signal.Notify(guard, USR2)
+	defer signal.Stop(guard)
+
+	Handlers{
+		USR2: func() { calls <- true },
+	}.TrackOnce()
+
+	c.Assert(Send(os.Getpid(), USR2), IsNil)
+	c.Assert(waitFor(calls), Equals, true)
+	c.Assert(waitForSignal(guard), Equals, true)
+
+	c.Assert(Send(os.Getpid(), USR2), IsNil)
+	c.Assert(waitForSignal(guard), Equals, true)
+
+	time.Sleep(50 * time.Millisecond)
+
+	c.Assert(calls, HasLen, 0)
+}
+
+func (s *SignalSuite) TestGracefulShutdown(c *C) {
+	codes := make(chan int, 1)
+	release := make(chan bool)
+
+	exitFunc = func(code int) { codes <- code }
+	defer func() { exitFunc = os.Exit }()
+	defer close(release)
+
+	var order []int
+
+	GracefulShutdown(time.Second,
+		func() { order = append(order, 1) },
+		nil,
+		func() { order = append(order, 2) },
+	)
+
+	c.Assert(Send(os.Getpid(), TERM), IsNil)
+	c.Assert(waitForCode(codes), Equals, 0)
+	c.Assert(order, DeepEquals, []int{1, 2})
+
+	GracefulShutdown(50*time.Millisecond, func() { <-release })
+
+	c.Assert(Send(os.Getpid(), INT), IsNil)
+	c.Assert(waitForCode(codes), Equals, 1)
+
+	started := make(chan bool, 1)
+
+	GracefulShutdown(0, func() {
+		started <- true
+		<-release
+	})
+
+	c.Assert(Send(os.Getpid(), QUIT), IsNil)
+	c.Assert(waitFor(started), Equals, true)
+	c.Assert(Send(os.Getpid(), QUIT), IsNil)
+	c.Assert(waitForCode(codes), Equals, 1)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func waitFor(ch chan bool) bool {
+	select {
+	case <-ch:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
+
+func waitForSignal(ch chan os.Signal) bool {
+	select {
+	case <-ch:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
+
+func waitForCode(ch chan int) int {
+	select {
+	case code := <-ch:
+		return code
+	case <-time.After(time.Second):
+		return -1
+	}
+}
@@ -9,6 +9,12 @@ package signal
 //                                                                                    //
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+import (
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 const (
 	ABRT   = 0
 	ALRM   = 0
@@ -62,4 +68,13 @@ func (h Handlers) Track() {}
 // TrackAsync catch signal and execute async handler for this signal
 func (h Handlers) TrackAsync() {}
 
+// TrackOnce catch signal and execute handler for this signal only once
+func (h Handlers) TrackOnce() {}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GracefulShutdown catch TERM, INT and QUIT signals, execute given handlers
+// and exit
+func GracefulShutdown(timeout time.Duration, handlers ...func()) {}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
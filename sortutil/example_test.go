@@ -30,7 +30,7 @@ func ExampleVersions() {
 	fmt.Println(versionSlice)
 
 	// Output:
-	// [1 1.1 1.1.6 1.3 1.3b 2.0 2.0-1 2.0-5]
+	// [1 1.1 1.1.6 1.3 1.3b 2.0-1 2.0-5 2.0]
 }
 
 func ExampleStrings() {
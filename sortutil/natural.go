@@ -7,7 +7,10 @@ package sortutil
 //                                                                                    //
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-import "sort"
+import (
+	"sort"
+	"strings"
+)
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
@@ -83,6 +86,19 @@ func NaturalLess(s1, s2 string) bool {
 	return l1 < l2
 }
 
+// NaturalLessInsensitive compares two strings using case insensitive natural
+// ordering. Strings which differ only in case are compared case sensitive,
+// so result of sorting is always the same.
+func NaturalLessInsensitive(s1, s2 string) bool {
+	ls1, ls2 := strings.ToLower(s1), strings.ToLower(s2)
+
+	if ls1 == ls2 {
+		return NaturalLess(s1, s2)
+	}
+
+	return NaturalLess(ls1, ls2)
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 func isDigit(b byte) bool {
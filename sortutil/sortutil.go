@@ -12,6 +12,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+
+	"pkg.re/essentialkaos/ek.v7/version"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -30,19 +32,52 @@ func (s versionSlice) Less(i, j int) bool {
 func (s stringSlice) Len() int      { return len(s) }
 func (s stringSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 func (s stringSlice) Less(i, j int) bool {
-	return strings.ToLower(s[i]) < strings.ToLower(s[j])
+	return NaturalLessInsensitive(s[i], s[j])
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// Versions sort versions slice
+// Versions sort versions slice (versions are compared using version package)
 func Versions(s []string) {
 	sort.Sort(versionSlice(s))
 }
 
 // VersionCompare compare 2 versions and return true if v1 less v2. This function
-// can be used for version sorting with structs
+// can be used for version sorting with structs. Versions which can be parsed
+// by version package are compared by SemVer rules (so 1.0-beta < 1.0), others
+// are compared part by part.
 func VersionCompare(v1, v2 string) bool {
+	ver1, err1 := version.Parse(v1)
+	ver2, err2 := version.Parse(v2)
+
+	if err1 == nil && err2 == nil {
+		switch version.Compare(ver1, ver2) {
+		case -1:
+			return true
+		case 1:
+			return false
+		}
+
+		return v1 < v2
+	}
+
+	return versionCompareParts(v1, v2)
+}
+
+// Strings sort strings slice in natural order (so "file2" < "file10") and
+// support case insensitive mode
+func Strings(s []string, caseInsensitive bool) {
+	if caseInsensitive {
+		sort.Sort(stringSlice(s))
+	} else {
+		sort.Sort(naturalSlice(s))
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// versionCompareParts compare versions part by part
+func versionCompareParts(v1, v2 string) bool {
 	is := strings.Split(v1, ".")
 	js := strings.Split(v2, ".")
 
@@ -83,12 +118,3 @@ func VersionCompare(v1, v2 string) bool {
 
 	return true
 }
-
-// Strings sort strings slice and support case insensitive mode
-func Strings(s []string, caseInsensitive bool) {
-	if caseInsensitive {
-		sort.Sort(stringSlice(s))
-	} else {
-		sort.Strings(s)
-	}
-}
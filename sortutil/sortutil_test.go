@@ -38,7 +38,20 @@ func (s *SortSuite) TestVersionSorting(c *C) {
 
 	Versions(v2)
 
-	c.Assert(v2, DeepEquals, []string{"1", "1-2", "2", "2.2.3", "2.2.3"})
+	c.Assert(v2, DeepEquals, []string{"1-2", "1", "2", "2.2.3", "2.2.3"})
+
+	v3 := []string{"1.0.0", "1.0.0-rc1", "1.0.0-beta2", "1.0.0-beta10", "0.9"}
+
+	Versions(v3)
+
+	c.Assert(v3, DeepEquals, []string{"0.9", "1.0.0-beta2", "1.0.0-beta10", "1.0.0-rc1", "1.0.0"})
+}
+
+func (s *SortSuite) TestVersionCompare(c *C) {
+	c.Assert(VersionCompare("1.0.0", "1.0.0"), Equals, false)
+	c.Assert(VersionCompare("1.0.0-rc1", "1.0.0-rc1"), Equals, false)
+	c.Assert(VersionCompare("1.0.0-rc1", "1.0.0"), Equals, true)
+	c.Assert(VersionCompare("1.0.0", "1.0.0-rc1"), Equals, false)
 }
 
 func (s *SortSuite) TestStringSorting(c *C) {
@@ -50,6 +63,15 @@ func (s *SortSuite) TestStringSorting(c *C) {
 
 	c.Assert(s1, DeepEquals, []string{"7", "Apple", "Monica", "auto", "flower", "image", "moon"})
 	c.Assert(s2, DeepEquals, []string{"7", "Apple", "auto", "flower", "image", "Monica", "moon"})
+
+	s3 := []string{"file10", "File2", "file1", "file2", "File10"}
+	s4 := []string{"file10", "File2", "file1", "file2", "File10"}
+
+	Strings(s3, false)
+	Strings(s4, true)
+
+	c.Assert(s3, DeepEquals, []string{"File2", "File10", "file1", "file2", "file10"})
+	c.Assert(s4, DeepEquals, []string{"file1", "File2", "file2", "File10", "file10"})
 }
 
 func (s *SortSuite) TestNaturalSorting(c *C) {
@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"pkg.re/essentialkaos/ek.v7/mathutil"
+	"pkg.re/essentialkaos/ek.v7/strutil"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -120,74 +121,11 @@ func (m *Model) Suggest(word string, max int) []string {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// Damerau–Levenshtein distance algorithm and code
-func getDLDistance(source, target string) int {
-	sl := len(source)
-	tl := len(target)
-
-	if sl == 0 {
-		if tl == 0 {
-			return 0
-		}
-		return tl
-	} else if tl == 0 {
-		return sl
-	}
-
-	h := make([][]int, sl+2)
-
-	for i := range h {
-		h[i] = make([]int, tl+2)
-	}
-
-	ll := sl + tl
-
-	h[0][0] = ll
-
-	for i := 0; i <= sl; i++ {
-		h[i+1][0] = ll
-		h[i+1][1] = i
-	}
-
-	for j := 0; j <= tl; j++ {
-		h[0][j+1] = ll
-		h[1][j+1] = j
-	}
-
-	sd := make(map[rune]int)
-
-	for _, rn := range source + target {
-		sd[rn] = 0
-	}
-
-	for i := 1; i <= sl; i++ {
-		d := 0
-
-		for j := 1; j <= tl; j++ {
-			i1 := sd[rune(target[j-1])]
-			j1 := d
-
-			if source[i-1] == target[j-1] {
-				h[i+1][j+1] = h[i][j]
-				d = j
-			} else {
-				h[i+1][j+1] = mathutil.Min(h[i][j], mathutil.Min(h[i+1][j], h[i][j+1])) + 1
-			}
-
-			h[i+1][j+1] = mathutil.Min(h[i+1][j+1], h[i1][j1]+(i-i1-1)+1+(j-j1-1))
-		}
-
-		sd[rune(source[i-1])] = i
-	}
-
-	return h[sl+1][tl+1]
-}
-
 func getSuggestSlice(terms []string, word string) suggestItems {
 	var result suggestItems
 
 	for _, t := range terms {
-		result = append(result, &suggestItem{t, getDLDistance(strings.ToLower(t), strings.ToLower(word))})
+		result = append(result, &suggestItem{t, strutil.Levenshtein(strings.ToLower(t), strings.ToLower(word))})
 	}
 
 	return result
@@ -82,3 +82,36 @@ func ExampleFields() {
 	// Output:
 	// []string{"Bob", "Alice", "Mary Key", "John Dow"}
 }
+
+
+func ExampleReadField() {
+	fmt.Println(ReadField("Bob    Alice   John Mary", 2, true, " "))
+	fmt.Println(ReadField("Bob:::Mary", 3, false, ":"))
+
+	// Output:
+	// John
+	// Mary
+}
+
+func ExampleExclude() {
+	fmt.Println(Exclude("This is funny message", " funny"))
+
+	// Output:
+	// This is message
+}
+
+func ExampleLevenshtein() {
+	fmt.Println(Levenshtein("kitten", "sitting"))
+
+	// Output:
+	// 3
+}
+
+func ExampleSuggest() {
+	options := []string{"--help", "--version", "--verbose"}
+
+	fmt.Printf("Did you mean %s?\n", Suggest("--verbse", options, 1)[0])
+
+	// Output:
+	// Did you mean --verbose?
+}
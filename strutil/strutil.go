@@ -10,7 +10,9 @@ package strutil
 
 import (
 	"bytes"
+	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -26,9 +28,10 @@ func Concat(s ...string) string {
 	return buffer.String()
 }
 
-// Substr return substring from given string
+// Substr return substring from given string (symbols from start index to
+// end index, end index is excluded)
 func Substr(s string, start int, end int) string {
-	if s == "" {
+	if s == "" || end <= start || end <= 0 {
 		return ""
 	}
 
@@ -48,7 +51,7 @@ func Substr(s string, start int, end int) string {
 	}
 
 	switch {
-	case count < start:
+	case count <= start:
 		return ""
 	case startIndex != 0:
 		return s[startIndex:]
@@ -78,6 +81,10 @@ func Ellipsis(s string, maxSize int) string {
 		return s
 	}
 
+	if maxSize <= 3 {
+		return Head(s, maxSize)
+	}
+
 	return Substr(s, 0, maxSize-3) + "..."
 }
 
@@ -119,8 +126,8 @@ func PrefixSize(str string, prefix rune) int {
 
 	var result int
 
-	for i := 0; i < len(str); i++ {
-		if rune(str[i]) != prefix {
+	for _, r := range str {
+		if r != prefix {
 			return result
 		}
 
@@ -138,11 +145,14 @@ func SuffixSize(str string, suffix rune) int {
 
 	var result int
 
-	for i := len(str) - 1; i >= 0; i-- {
-		if rune(str[i]) != suffix {
+	for str != "" {
+		r, size := utf8.DecodeLastRuneInString(str)
+
+		if r != suffix {
 			return result
 		}
 
+		str = str[:len(str)-size]
 		result++
 	}
 
@@ -173,22 +183,28 @@ SOURCELOOP:
 }
 
 // Fields splits the string data around each instance of one or more
-// consecutive white space or comma characters
+// consecutive white space or comma characters. Items can be quoted by
+// double quotes, single quotes or backticks, other quotes inside quoted
+// item are kept as is.
 func Fields(data string) []string {
 	var (
 		result    []string
 		item      string
+		quote     rune
 		waitQuote bool
 	)
 
 	for _, char := range data {
 		switch char {
 		case '"', '\'', '`':
-			if !waitQuote {
-				waitQuote = true
-			} else {
+			switch {
+			case !waitQuote:
+				quote, waitQuote = char, true
+			case char == quote:
 				result = append(result, item)
 				item, waitQuote = "", false
+			default:
+				item += string(char)
 			}
 
 		case ',', ' ':
@@ -211,13 +227,175 @@ func Fields(data string) []string {
 	return formatItems(result)
 }
 
+// ReadField read field with given index from data. Fields are separated by
+// any of given separator symbols (if separators is empty, spaces and tabs
+// are used). If multiSep is true, consecutive separators are treated
+// as one.
+func ReadField(data string, index int, multiSep bool, separators string) string {
+	if data == "" || index < 0 {
+		return ""
+	}
+
+	if separators == "" {
+		separators = " \t"
+	}
+
+	var curIndex, startIndex int
+
+	for i, r := range data {
+		if !strings.ContainsRune(separators, r) {
+			continue
+		}
+
+		// Skip leading and consecutive separators
+		if multiSep && i == startIndex {
+			startIndex = i + utf8.RuneLen(r)
+			continue
+		}
+
+		if curIndex == index {
+			return data[startIndex:i]
+		}
+
+		curIndex++
+		startIndex = i + utf8.RuneLen(r)
+	}
+
+	if curIndex != index || (multiSep && startIndex == len(data)) {
+		return ""
+	}
+
+	return data[startIndex:]
+}
+
+// Exclude remove all occurrences of given substring from data
+func Exclude(data, substr string) string {
+	if data == "" || substr == "" {
+		return data
+	}
+
+	return strings.Replace(data, substr, "", -1)
+}
+
+// Levenshtein return Damerau–Levenshtein distance between two strings (minimal
+// number of symbols insertions, deletions, substitutions and transpositions of
+// two adjacent symbols required for changing one string into the other)
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	al, bl := len(ra), len(rb)
+
+	switch {
+	case al == 0:
+		return bl
+	case bl == 0:
+		return al
+	}
+
+	h := make([][]int, al+2)
+
+	for i := range h {
+		h[i] = make([]int, bl+2)
+	}
+
+	ll := al + bl
+
+	h[0][0] = ll
+
+	for i := 0; i <= al; i++ {
+		h[i+1][0] = ll
+		h[i+1][1] = i
+	}
+
+	for j := 0; j <= bl; j++ {
+		h[0][j+1] = ll
+		h[1][j+1] = j
+	}
+
+	// Last row where symbol was found in a
+	sd := make(map[rune]int)
+
+	for i := 1; i <= al; i++ {
+		d := 0
+
+		for j := 1; j <= bl; j++ {
+			i1 := sd[rb[j-1]]
+			j1 := d
+
+			if ra[i-1] == rb[j-1] {
+				h[i+1][j+1] = h[i][j]
+				d = j
+			} else {
+				h[i+1][j+1] = min(h[i][j], h[i+1][j], h[i][j+1]) + 1
+			}
+
+			h[i+1][j+1] = min(h[i+1][j+1], h[i1][j1]+(i-i1-1)+1+(j-j1-1))
+		}
+
+		sd[ra[i-1]] = i
+	}
+
+	return h[al+1][bl+1]
+}
+
+// Suggest return up to max candidates similar to given input ordered by
+// similarity. Candidate is similar if Levenshtein distance between it and
+// input is less or equal to one third of input length (but at least 1).
+func Suggest(input string, candidates []string, max int) []string {
+	if input == "" || len(candidates) == 0 || max <= 0 {
+		return nil
+	}
+
+	maxDistance := Len(input) / 3
+
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	var suggestions suggestionList
+
+	for _, candidate := range candidates {
+		distance := Levenshtein(input, candidate)
+
+		if distance <= maxDistance {
+			suggestions = append(suggestions, suggestion{candidate, distance})
+		}
+	}
+
+	sort.Stable(suggestions)
+
+	var result []string
+
+	for index, s := range suggestions {
+		if index == max {
+			break
+		}
+
+		result = append(result, s.value)
+	}
+
+	return result
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type suggestion struct {
+	value    string
+	distance int
+}
+
+type suggestionList []suggestion
+
+func (s suggestionList) Len() int           { return len(s) }
+func (s suggestionList) Less(i, j int) bool { return s[i].distance < s[j].distance }
+func (s suggestionList) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 func formatItems(data []string) []string {
 	var result []string
 
 	for _, v := range data {
-		item := strings.Replace(strings.TrimSpace(v), "\"", "", -1)
+		item := strings.TrimSpace(v)
 
 		if item != "" {
 			result = append(result, item)
@@ -226,3 +404,15 @@ func formatItems(data []string) []string {
 
 	return result
 }
+
+func min(values ...int) int {
+	result := values[0]
+
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+
+	return result
+}
@@ -42,6 +42,13 @@ func (s *StrUtilSuite) TestSubstr(c *C) {
 	c.Assert(Substr("test1234TEST", 4, 8), Equals, "1234")
 	c.Assert(Substr("test1234TEST", 8, 16), Equals, "TEST")
 	c.Assert(Substr("test1234TEST", -1, 4), Equals, "test")
+	c.Assert(Substr("test1234TEST", 6, 2), Equals, "")
+	c.Assert(Substr("test1234TEST", 0, -2), Equals, "")
+	c.Assert(Substr("✶✈12AB例例子예", 6, 9), Equals, "例例子")
+	c.Assert(Substr("abc", 3, 5), Equals, "")
+	c.Assert(Substr("abc", 2, 5), Equals, "c")
+	c.Assert(Substr("привет", 6, 10), Equals, "")
+	c.Assert(Substr("привет", 5, 10), Equals, "т")
 }
 
 func (s *StrUtilSuite) BenchmarkSubstr(c *C) {
@@ -53,6 +60,8 @@ func (s *StrUtilSuite) BenchmarkSubstr(c *C) {
 func (s *StrUtilSuite) TestEllipsis(c *C) {
 	c.Assert(Ellipsis("Test1234", 8), Equals, "Test1234")
 	c.Assert(Ellipsis("Test1234test", 8), Equals, "Test1...")
+	c.Assert(Ellipsis("Test1234test", 2), Equals, "Te")
+	c.Assert(Ellipsis("✶✈12AB例例子예", 8), Equals, "✶✈12A...")
 }
 
 func (s *StrUtilSuite) BenchmarkEllipsis(c *C) {
@@ -101,6 +110,10 @@ func (s *StrUtilSuite) TestSize(c *C) {
 	c.Assert(SuffixSize("abcd", ' '), Equals, 0)
 	c.Assert(SuffixSize("abcd    ", ' '), Equals, 4)
 	c.Assert(SuffixSize("    ", ' '), Equals, 4)
+
+	c.Assert(PrefixSize("例例子", '例'), Equals, 2)
+	c.Assert(SuffixSize("子예예", '예'), Equals, 2)
+	c.Assert(PrefixSize("╚abc", 'a'), Equals, 0)
 }
 
 func (s *StrUtilSuite) BenchmarkSize(c *C) {
@@ -121,6 +134,7 @@ func (s *StrUtilSuite) TestFields(c *C) {
 	c.Assert(Fields("1,  2, 3,   4, 5"), DeepEquals, []string{"1", "2", "3", "4", "5"})
 	c.Assert(Fields("\"1 2\" 3 \"4 5\""), DeepEquals, []string{"1 2", "3", "4 5"})
 	c.Assert(Fields("'1 2' 3 '4 5'"), DeepEquals, []string{"1 2", "3", "4 5"})
+	c.Assert(Fields("'Say \"Hi\"' `it's`"), DeepEquals, []string{"Say \"Hi\"", "it's"})
 }
 
 func (s *StrUtilSuite) BenchmarkFields(c *C) {
@@ -129,6 +143,66 @@ func (s *StrUtilSuite) BenchmarkFields(c *C) {
 	}
 }
 
+func (s *StrUtilSuite) TestReadField(c *C) {
+	c.Assert(ReadField("", 0, false, ""), Equals, "")
+	c.Assert(ReadField("abc", -1, false, ""), Equals, "")
+	c.Assert(ReadField("abc", 0, false, ""), Equals, "abc")
+	c.Assert(ReadField("abc", 1, false, ""), Equals, "")
+	c.Assert(ReadField("a b\tc", 2, false, ""), Equals, "c")
+	c.Assert(ReadField("a  b", 1, false, ""), Equals, "")
+	c.Assert(ReadField("a  b", 2, false, ""), Equals, "b")
+	c.Assert(ReadField("  a  b  ", 0, true, ""), Equals, "a")
+	c.Assert(ReadField("  a  b  ", 1, true, ""), Equals, "b")
+	c.Assert(ReadField("  a  b  ", 2, true, ""), Equals, "")
+	c.Assert(ReadField("1:2;3", 2, false, ":;"), Equals, "3")
+	c.Assert(ReadField("例✶子✶✶예", 2, true, "✶"), Equals, "예")
+}
+
+func (s *StrUtilSuite) BenchmarkReadField(c *C) {
+	for i := 0; i < c.N; i++ {
+		ReadField("abc  1234  DEF", 2, true, " ")
+	}
+}
+
+func (s *StrUtilSuite) TestExclude(c *C) {
+	c.Assert(Exclude("", "abc"), Equals, "")
+	c.Assert(Exclude("abc", ""), Equals, "abc")
+	c.Assert(Exclude("My string contains 2 strings", "string"), Equals, "My  contains 2 s")
+}
+
+func (s *StrUtilSuite) TestLevenshtein(c *C) {
+	c.Assert(Levenshtein("", ""), Equals, 0)
+	c.Assert(Levenshtein("", "abc"), Equals, 3)
+	c.Assert(Levenshtein("abc", ""), Equals, 3)
+	c.Assert(Levenshtein("kitten", "sitting"), Equals, 3)
+	c.Assert(Levenshtein("flaw", "lawn"), Equals, 2)
+	c.Assert(Levenshtein("verbse", "verbose"), Equals, 1)
+	c.Assert(Levenshtein("hepl", "help"), Equals, 1)
+	c.Assert(Levenshtein("ca", "abc"), Equals, 2)
+	c.Assert(Levenshtein("例子", "子例"), Equals, 1)
+	c.Assert(Levenshtein("例例子", "例子"), Equals, 1)
+	c.Assert(Levenshtein("test", "test"), Equals, 0)
+}
+
+func (s *StrUtilSuite) BenchmarkLevenshtein(c *C) {
+	for i := 0; i < c.N; i++ {
+		Levenshtein("kitten", "sitting")
+	}
+}
+
+func (s *StrUtilSuite) TestSuggest(c *C) {
+	candidates := []string{"--version", "--verbose", "--help", "--verb"}
+
+	c.Assert(Suggest("", candidates, 3), IsNil)
+	c.Assert(Suggest("--verbse", nil, 3), IsNil)
+	c.Assert(Suggest("--verbse", candidates, 0), IsNil)
+	c.Assert(Suggest("--unknown", candidates, 3), IsNil)
+	c.Assert(Suggest("--verbse", candidates, 3), DeepEquals, []string{"--verbose", "--verb"})
+	c.Assert(Suggest("--verbse", candidates, 1), DeepEquals, []string{"--verbose"})
+	c.Assert(Suggest("--hepl", candidates, 3), DeepEquals, []string{"--help"})
+	c.Assert(Suggest("ab", []string{"ac", "ad", "xy"}, 3), DeepEquals, []string{"ac", "ad"})
+}
+
 func (s *StrUtilSuite) TestLen(c *C) {
 	c.Assert(Len("ABCDABCD12341234"), Equals, 16)
 	c.Assert(Len(""), Equals, 0)
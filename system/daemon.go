@@ -0,0 +1,196 @@
+// +build linux, darwin, !windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// _DAEMON_STAGE_ENV is name of environment variable with daemonization stage
+const _DAEMON_STAGE_ENV = "EK_DAEMON_STAGE"
+
+// Daemonization stages
+const (
+	_DAEMON_STAGE_SESSION = "1" // First child, session leader
+	_DAEMON_STAGE_DAEMON  = "2" // Second child, daemon process
+)
+
+// _DAEMON_PID_PERMS is permissions of created pid file
+const _DAEMON_PID_PERMS = 0644
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DaemonOptions contains daemonization options
+type DaemonOptions struct {
+	LogFile string // Path to file for stdout/stderr output (/dev/null by default)
+	WorkDir string // Working directory ("/" by default)
+	PidFile string // Path to pid file (pid file will not be created if empty)
+	Umask   int    // File mode creation mask
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// isDaemon is true if current process is daemon process (stage environment
+// variable is removed after daemonization, so we keep state here)
+var isDaemon = os.Getenv(_DAEMON_STAGE_ENV) == _DAEMON_STAGE_DAEMON
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Daemonize detach current process from terminal. Since go runtime doesn't
+// support fork, original process is restarted twice (first time as session
+// leader, second time as daemon). Parent processes will be stopped by this
+// method, so it must be called as early as possible. In daemon process
+// method returns nil.
+func Daemonize(opts *DaemonOptions) error {
+	if opts == nil {
+		opts = &DaemonOptions{}
+	}
+
+	switch os.Getenv(_DAEMON_STAGE_ENV) {
+	case "":
+		return startDaemonStage(opts, _DAEMON_STAGE_SESSION)
+	case _DAEMON_STAGE_SESSION:
+		return startDaemonStage(opts, _DAEMON_STAGE_DAEMON)
+	}
+
+	isDaemon = true
+
+	os.Unsetenv(_DAEMON_STAGE_ENV)
+
+	syscall.Umask(opts.Umask)
+
+	if opts.PidFile != "" {
+		return writeDaemonPid(opts.PidFile)
+	}
+
+	return nil
+}
+
+// IsDaemon return true if current process was started by Daemonize
+func IsDaemon() bool {
+	return isDaemon
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// startDaemonStage start copy of current process and stop current process
+func startDaemonStage(opts *DaemonOptions, stage string) error {
+	binary, err := getBinaryPath()
+
+	if err != nil {
+		return err
+	}
+
+	nullFile, err := os.Open(os.DevNull)
+
+	if err != nil {
+		return err
+	}
+
+	defer nullFile.Close()
+
+	output, err := getDaemonOutput(opts.LogFile)
+
+	if err != nil {
+		return err
+	}
+
+	defer output.Close()
+
+	cmd := exec.Command(binary)
+
+	cmd.Args = os.Args
+	cmd.Dir = opts.WorkDir
+	cmd.Env = append(getDaemonEnv(), _DAEMON_STAGE_ENV+"="+stage)
+	cmd.Stdin = nullFile
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	if cmd.Dir == "" {
+		cmd.Dir = "/"
+	}
+
+	if stage == _DAEMON_STAGE_SESSION {
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	}
+
+	err = cmd.Start()
+
+	if err != nil {
+		return errors.New("Can't start daemon process: " + err.Error())
+	}
+
+	os.Exit(0)
+
+	return nil
+}
+
+// getBinaryPath return absolute path to current binary
+func getBinaryPath() (string, error) {
+	binary, err := exec.LookPath(os.Args[0])
+
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Abs(binary)
+}
+
+// getDaemonOutput open file for daemon stdout/stderr
+func getDaemonOutput(logFile string) (*os.File, error) {
+	if logFile == "" {
+		return os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	}
+
+	return os.OpenFile(logFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+}
+
+// getDaemonEnv return current environment without daemonization stage variable
+func getDaemonEnv() []string {
+	var result []string
+
+	for _, v := range os.Environ() {
+		if !strings.HasPrefix(v, _DAEMON_STAGE_ENV+"=") {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// writeDaemonPid write pid of current process to given file
+func writeDaemonPid(file string) error {
+	// Old pid file is removed and new one is created with O_EXCL, so
+	// we never write pid to file by symlink created by someone else
+	os.Remove(file)
+
+	fd, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, _DAEMON_PID_PERMS)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(fd, "%d\n", os.Getpid())
+
+	if err != nil {
+		fd.Close()
+		return err
+	}
+
+	return fd.Close()
+}
@@ -0,0 +1,32 @@
+// +build !linux, !darwin, windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DaemonOptions contains daemonization options
+type DaemonOptions struct {
+	LogFile string // Path to file for stdout/stderr output (/dev/null by default)
+	WorkDir string // Working directory ("/" by default)
+	PidFile string // Path to pid file (pid file will not be created if empty)
+	Umask   int    // File mode creation mask
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Daemonize detach current process from terminal
+func Daemonize(opts *DaemonOptions) error {
+	return nil
+}
+
+// IsDaemon return true if current process was started by Daemonize
+func IsDaemon() bool {
+	return false
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -0,0 +1,34 @@
+// +build darwin
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// RNGInfo contains info about kernel random number generator
+type RNGInfo struct {
+	EntropyAvail         int    `json:"entropy_avail"`           // Available entropy (bits)
+	PoolSize             int    `json:"pool_size"`               // Entropy pool size (bits)
+	ReadWakeupThreshold  int    `json:"read_wakeup_threshold"`   // Entropy level for waking up readers of /dev/random (0 if not supported by kernel)
+	WriteWakeupThreshold int    `json:"write_wakeup_threshold"`  // Entropy level for waking up writers to /dev/random
+	URandomMinReseedSecs int    `json:"urandom_min_reseed_secs"` // Minimal interval between urandom reseeds (0 if not supported by kernel)
+	BootID               string `json:"boot_id"`                 // Random UUID generated on boot
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetEntropyAvail return available entropy in bits
+// (not supported on this system)
+func GetEntropyAvail() (int, error) {
+	return 0, ErrNotSupported
+}
+
+// GetRNGInfo return info about kernel random number generator
+// (not supported on this system)
+func GetRNGInfo() (*RNGInfo, error) {
+	return nil, ErrNotSupported
+}
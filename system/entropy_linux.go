@@ -0,0 +1,96 @@
+// +build linux
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const _PROC_RANDOM_DIR = "/proc/sys/kernel/random"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// RNGInfo contains info about kernel random number generator
+type RNGInfo struct {
+	EntropyAvail         int    `json:"entropy_avail"`           // Available entropy (bits)
+	PoolSize             int    `json:"pool_size"`               // Entropy pool size (bits)
+	ReadWakeupThreshold  int    `json:"read_wakeup_threshold"`   // Entropy level for waking up readers of /dev/random (0 if not supported by kernel)
+	WriteWakeupThreshold int    `json:"write_wakeup_threshold"`  // Entropy level for waking up writers to /dev/random
+	URandomMinReseedSecs int    `json:"urandom_min_reseed_secs"` // Minimal interval between urandom reseeds (0 if not supported by kernel)
+	BootID               string `json:"boot_id"`                 // Random UUID generated on boot
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetEntropyAvail return available entropy in bits
+func GetEntropyAvail() (int, error) {
+	return readRandomValue("entropy_avail")
+}
+
+// GetRNGInfo return info about kernel random number generator
+func GetRNGInfo() (*RNGInfo, error) {
+	var err error
+
+	result := &RNGInfo{}
+
+	result.EntropyAvail, err = readRandomValue("entropy_avail")
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.PoolSize, err = readRandomValue("poolsize")
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.WriteWakeupThreshold, err = readRandomValue("write_wakeup_threshold")
+
+	if err != nil {
+		return nil, err
+	}
+
+	// These values are not available on all kernels
+	result.ReadWakeupThreshold, _ = readRandomValue("read_wakeup_threshold")
+	result.URandomMinReseedSecs, _ = readRandomValue("urandom_min_reseed_secs")
+
+	bootID, err := readFileContent(_PROC_RANDOM_DIR + "/boot_id")
+
+	if err == nil {
+		result.BootID = strings.TrimSpace(bootID[0])
+	}
+
+	return result, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// readRandomValue read numeric value from kernel random directory
+func readRandomValue(name string) (int, error) {
+	file := _PROC_RANDOM_DIR + "/" + name
+	content, err := readFileContent(file)
+
+	if err != nil {
+		return 0, err
+	}
+
+	value, err := strconv.Atoi(strings.TrimSpace(content[0]))
+
+	if err != nil {
+		return 0, errors.New("Can't parse file " + file)
+	}
+
+	return value, nil
+}
@@ -9,6 +9,7 @@ package system
 
 import (
 	"fmt"
+	"syscall"
 	"time"
 )
 
@@ -30,13 +31,21 @@ func ExampleSudoExec() {
 	}
 }
 
-func ExampleRunAsUser() {
-	// run echo as user some user and redirect output to /var/log/output.log
-	err := RunAsUser("someuser", "/var/log/output.log", "/bin/echo", "abc", "123")
+func ExampleExecAsUser() {
+	// run echo as some user without possibility to gain new privileges
+	result, err := ExecAsUser("someuser", ExecCommand{
+		Command:    "/bin/echo",
+		Args:       []string{"abc", "123"},
+		NoNewPrivs: true,
+	})
 
 	if err != nil {
 		fmt.Printf("Error: %v", err)
+		return
 	}
+
+	fmt.Printf("Exit code: %d\n", result.ExitCode)
+	fmt.Printf("Output: %s\n", result.Stdout)
 }
 
 func ExampleGetFSInfo() {
@@ -219,3 +228,143 @@ func ExampleCurrentUser() {
 	fmt.Printf("IsRoot: %t\n", user.IsRoot())
 	fmt.Printf("IsSudo: %t\n", user.IsSudo())
 }
+
+func ExampleSendSignal() {
+	// send HUP signal to process with PID 1234
+	err := SendSignal(1234, syscall.SIGHUP)
+
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+	}
+}
+
+func ExampleIsProcessAlive() {
+	fmt.Printf("Process alive: %t\n", IsProcessAlive(1234))
+}
+
+func ExampleTerminateProcess() {
+	// send TERM signal to process and wait 10 seconds, if process
+	// still alive after this time, it will be killed
+	err := TerminateProcess(1234, 10*time.Second)
+
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+	}
+}
+
+func ExampleDaemonize() {
+	// all code before Daemonize will be executed 3 times (in original process
+	// and in two intermediate processes), so it must be called as early as possible
+	err := Daemonize(&DaemonOptions{
+		LogFile: "/var/log/myapp.log",
+		PidFile: "/var/run/myapp.pid",
+		WorkDir: "/",
+		Umask:   022,
+	})
+
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+		return
+	}
+
+	// daemon code
+}
+
+func ExampleSetNice() {
+	// decrease CPU priority of current process
+	err := SetNice(0, 10)
+
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+	}
+}
+
+func ExampleSetIOPriority() {
+	// use idle IO scheduling class for current process
+	err := SetIOPriority(0, IOPRIO_CLASS_IDLE, 7)
+
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+	}
+}
+
+func ExampleGetBootTime() {
+	bootTime, err := GetBootTime()
+
+	if err != nil {
+		return
+	}
+
+	// print boot time
+	fmt.Printf("Boot time: %v\n", bootTime)
+}
+
+func ExampleGetUptimeDuration() {
+	uptime, err := GetUptimeDuration()
+
+	if err != nil {
+		return
+	}
+
+	// print uptime in days
+	fmt.Printf("Uptime: %d days\n", int(uptime.Hours()/24))
+}
+
+func ExampleGetSwapDevices() {
+	devices, err := GetSwapDevices()
+
+	if err != nil {
+		return
+	}
+
+	// print info for each swap device
+	for _, device := range devices {
+		fmt.Printf(
+			"Path: %s Type: %s Size: %d Used: %d Priority: %d\n",
+			device.Path, device.Type, device.Size, device.Used, device.Priority,
+		)
+	}
+}
+
+func ExampleGetResolverInfo() {
+	info, err := GetResolverInfo()
+
+	if err != nil {
+		return
+	}
+
+	// print resolver configuration
+	fmt.Printf("Nameservers: %v\n", info.Nameservers)
+	fmt.Printf("Domain: %s\n", info.Domain)
+	fmt.Printf("Search: %v\n", info.Search)
+	fmt.Printf("Options: %v\n", info.Options)
+	fmt.Printf("Hosts lookup: %v\n", info.HostsLookup)
+}
+
+func ExampleSetHostname() {
+	err := SetHostname("server1.domain.com")
+
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+	}
+}
+
+func ExampleSetTimezone() {
+	err := SetTimezone("Europe/Moscow")
+
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+	}
+}
+
+func ExampleGetEntropyAvail() {
+	entropy, err := GetEntropyAvail()
+
+	if err != nil {
+		return
+	}
+
+	if entropy < 200 {
+		fmt.Println("Not enough entropy for generating keys")
+	}
+}
@@ -10,13 +10,42 @@ package system
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"syscall"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// _SECURE_PATH is value of PATH variable for commands executed by ExecAsUser
+const _SECURE_PATH = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ExecCommand contains info about command for ExecAsUser
+type ExecCommand struct {
+	Command    string    // Path to binary or binary name
+	Args       []string  // Command arguments
+	Dir        string    // Working directory (user home directory by default)
+	Env        []string  // Additional environment variables in "KEY=VALUE" format
+	Stdin      io.Reader // Command input
+	NoNewPrivs bool      // Forbid gaining new privileges (Linux only, requires Go 1.10+)
+}
+
+// ExecResult contains command execution result
+type ExecResult struct {
+	Stdout   []byte // Captured stdout data
+	Stderr   []byte // Captured stderr data
+	ExitCode int    // Command exit code
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // SudoExec execute some command with sudo
 func SudoExec(user string, args ...string) error {
 	var cmdArgs []string
@@ -36,34 +65,123 @@ func Exec(command string, args ...string) error {
 	return cmd.Run()
 }
 
-// RunAsUser run command as some user
-func RunAsUser(user, logFile string, command string, args ...string) error {
-	var log *os.File
-	var err error
+// ExecAsUser execute command as given user. Before command execution
+// supplementary groups, group and user will be changed (in this order),
+// and environment will be cleaned. Binary name without slashes is looked
+// up in secure PATH instead of PATH of current process.
+func ExecAsUser(user string, command ExecCommand) (*ExecResult, error) {
+	if command.Command == "" {
+		return nil, errors.New("Command can't be blank")
+	}
 
-	if logFile != "" {
-		log, err = os.OpenFile(logFile, os.O_APPEND|os.O_WRONLY, 0644)
+	userInfo, err := LookupUser(user)
 
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return nil, err
+	}
+
+	binary, err := lookSecurePath(command.Command)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(binary, command.Args...)
+
+	cmd.Dir = command.Dir
+	cmd.Env = append(getUserEnv(userInfo), command.Env...)
+	cmd.Stdin = command.Stdin
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{
+			Uid:    uint32(userInfo.UID),
+			Gid:    uint32(userInfo.GID),
+			Groups: getUserGroupsIDs(userInfo),
+		},
+	}
+
+	if cmd.Dir == "" {
+		cmd.Dir = userInfo.HomeDir
 	}
 
-	defer log.Close()
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
 
-	cmd := exec.Command(
-		"/sbin/runuser",
-		"-s", "/bin/bash",
-		user, "-c",
-		command, strings.Join(args, " "),
-	)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
 
-	if logFile != "" && log != nil {
-		cmd.Stderr = log
-		cmd.Stdout = log
+	if command.NoNewPrivs {
+		err = startWithNoNewPrivs(cmd)
+	} else {
+		err = cmd.Start()
 	}
 
-	return cmd.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cmd.Wait()
+
+	result := &ExecResult{
+		Stdout:   stdout.Bytes(),
+		Stderr:   stderr.Bytes(),
+		ExitCode: getExitCode(cmd),
+	}
+
+	return result, err
 }
 
 // ////////////////////////////////////////////////////////////////////////////////// //
+
+// getUserEnv return sanitized environment for given user
+func getUserEnv(user *User) []string {
+	return []string{
+		"HOME=" + user.HomeDir,
+		"USER=" + user.Name,
+		"LOGNAME=" + user.Name,
+		"SHELL=" + user.Shell,
+		"PATH=" + _SECURE_PATH,
+	}
+}
+
+// lookSecurePath search binary with given name in secure PATH
+func lookSecurePath(name string) (string, error) {
+	if strings.Contains(name, "/") {
+		return name, nil
+	}
+
+	for _, dir := range filepath.SplitList(_SECURE_PATH) {
+		path := filepath.Join(dir, name)
+		info, err := os.Stat(path)
+
+		if err == nil && info.Mode().IsRegular() && info.Mode()&0111 != 0 {
+			return path, nil
+		}
+	}
+
+	return "", &exec.Error{Name: name, Err: exec.ErrNotFound}
+}
+
+// getUserGroupsIDs return slice with IDs of user supplementary groups
+func getUserGroupsIDs(user *User) []uint32 {
+	var result []uint32
+
+	for _, group := range user.Groups {
+		result = append(result, uint32(group.GID))
+	}
+
+	return result
+}
+
+// getExitCode return exit code of finished command
+func getExitCode(cmd *exec.Cmd) int {
+	if cmd.ProcessState == nil {
+		return -1
+	}
+
+	status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus)
+
+	if !ok {
+		return -1
+	}
+
+	return status.ExitStatus()
+}
@@ -0,0 +1,22 @@
+// +build freebsd
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"os/exec"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// startWithNoNewPrivs return error because no_new_privs is not supported on this system
+func startWithNoNewPrivs(cmd *exec.Cmd) error {
+	return errors.New("NoNewPrivs is not supported on this system")
+}
@@ -0,0 +1,48 @@
+// +build linux,go1.10
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os/exec"
+	"runtime"
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const _PR_SET_NO_NEW_PRIVS = 38
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// startWithNoNewPrivs start command from dedicated OS thread with enabled
+// no_new_privs flag. Flag can't be unset, so thread is locked and never
+// unlocked (since Go 1.10 locked thread is terminated by runtime after
+// goroutine exit).
+func startWithNoNewPrivs(cmd *exec.Cmd) error {
+	errChan := make(chan error)
+
+	go func() {
+		runtime.LockOSThread()
+
+		_, _, errno := syscall.RawSyscall6(
+			syscall.SYS_PRCTL, _PR_SET_NO_NEW_PRIVS,
+			1, 0, 0, 0, 0,
+		)
+
+		if errno != 0 {
+			errChan <- errno
+			return
+		}
+
+		errChan <- cmd.Start()
+	}()
+
+	return <-errChan
+}
@@ -0,0 +1,23 @@
+// +build linux,!go1.10
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"os/exec"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// startWithNoNewPrivs return error because before Go 1.10 locked thread with
+// no_new_privs flag can be returned to threads pool after goroutine exit
+func startWithNoNewPrivs(cmd *exec.Cmd) error {
+	return errors.New("NoNewPrivs requires Go 1.10 or newer")
+}
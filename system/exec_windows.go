@@ -9,6 +9,31 @@ package system
 //                                                                                    //
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+import (
+	"io"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ExecCommand contains info about command for ExecAsUser
+type ExecCommand struct {
+	Command    string    // Path to binary or binary name
+	Args       []string  // Command arguments
+	Dir        string    // Working directory (user home directory by default)
+	Env        []string  // Additional environment variables in "KEY=VALUE" format
+	Stdin      io.Reader // Command input
+	NoNewPrivs bool      // Forbid gaining new privileges (setuid binaries/file capabilities)
+}
+
+// ExecResult contains command execution result
+type ExecResult struct {
+	Stdout   []byte // Captured stdout data
+	Stderr   []byte // Captured stderr data
+	ExitCode int    // Command exit code
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // SudoExec execute some command with sudo
 func SudoExec(user string, args ...string) error {
 	return nil
@@ -19,4 +44,9 @@ func Exec(command string, args ...string) error {
 	return nil
 }
 
+// ExecAsUser execute command as given user
+func ExecAsUser(user string, command ExecCommand) (*ExecResult, error) {
+	return &ExecResult{}, nil
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
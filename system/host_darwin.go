@@ -0,0 +1,85 @@
+// +build darwin,cgo
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+/*
+#include <mach/mach.h>
+#include <mach/mach_host.h>
+
+static kern_return_t get_cpu_load(host_cpu_load_info_data_t *info) {
+	mach_msg_type_number_t count = HOST_CPU_LOAD_INFO_COUNT;
+	return host_statistics(mach_host_self(), HOST_CPU_LOAD_INFO, (host_info_t)info, &count);
+}
+
+static kern_return_t get_vm_stats(vm_statistics64_data_t *info, vm_size_t *page_size) {
+	mach_msg_type_number_t count = HOST_VM_INFO64_COUNT;
+	kern_return_t ret = host_page_size(mach_host_self(), page_size);
+
+	if (ret != KERN_SUCCESS) {
+		return ret;
+	}
+
+	return host_statistics64(mach_host_self(), HOST_VM_INFO64, (host_info64_t)info, &count);
+}
+*/
+import "C"
+
+import (
+	"errors"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// vmStats contains VM statistics in bytes
+type vmStats struct {
+	Free        uint64
+	Speculative uint64
+	Active      uint64
+	Inactive    uint64
+	External    uint64
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getCPUTicks return CPU ticks (user, nice, system, idle) since boot
+func getCPUTicks() (float64, float64, float64, float64, error) {
+	var info C.host_cpu_load_info_data_t
+
+	if C.get_cpu_load(&info) != C.KERN_SUCCESS {
+		return 0, 0, 0, 0, errors.New("Can't read CPU info")
+	}
+
+	return float64(info.cpu_ticks[C.CPU_STATE_USER]),
+		float64(info.cpu_ticks[C.CPU_STATE_NICE]),
+		float64(info.cpu_ticks[C.CPU_STATE_SYSTEM]),
+		float64(info.cpu_ticks[C.CPU_STATE_IDLE]), nil
+}
+
+// getVMStats return VM statistics
+func getVMStats() (*vmStats, error) {
+	var (
+		info     C.vm_statistics64_data_t
+		pageSize C.vm_size_t
+	)
+
+	if C.get_vm_stats(&info, &pageSize) != C.KERN_SUCCESS {
+		return nil, errors.New("Can't read VM statistics")
+	}
+
+	size := uint64(pageSize)
+
+	return &vmStats{
+		Free:        uint64(info.free_count) * size,
+		Speculative: uint64(info.speculative_count) * size,
+		Active:      uint64(info.active_count) * size,
+		Inactive:    uint64(info.inactive_count) * size,
+		External:    uint64(info.external_page_count) * size,
+	}, nil
+}
@@ -0,0 +1,70 @@
+// +build darwin,!cgo
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// vmStats contains VM statistics in bytes
+type vmStats struct {
+	Free        uint64
+	Speculative uint64
+	Active      uint64
+	Inactive    uint64
+	External    uint64
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getCPUTicks return CPU ticks (user, nice, system, idle) since boot
+// (host statistics is not available without cgo)
+func getCPUTicks() (float64, float64, float64, float64, error) {
+	return 0, 0, 0, 0, ErrNotSupported
+}
+
+// getVMStats return VM statistics read from sysctl (active and inactive
+// pages are not available without cgo and always set to 0)
+func getVMStats() (*vmStats, error) {
+	pageSize, err := sysctlUint64("hw.pagesize")
+
+	if err != nil {
+		return nil, errors.New("Can't read page size")
+	}
+
+	free, err := sysctlUint64("vm.page_free_count")
+
+	if err != nil {
+		return nil, errors.New("Can't read VM stats")
+	}
+
+	speculative, _ := sysctlUint64("vm.page_speculative_count")
+	external, _ := sysctlUint64("vm.page_pageable_external_count")
+
+	return &vmStats{
+		Free:        free * pageSize,
+		Speculative: speculative * pageSize,
+		External:    external * pageSize,
+	}, nil
+}
+
+// sysctlUint64 return 32 or 64 bit numeric sysctl value
+func sysctlUint64(name string) (uint64, error) {
+	data, err := sysctlRaw(name, 8)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint64(data[0:8]), nil
+}
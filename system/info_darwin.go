@@ -10,14 +10,212 @@ package system
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"encoding/binary"
 	"errors"
 	"os/exec"
 	"strings"
 	"syscall"
+	"time"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// _MNT_NOWAIT is getfsstat flag for returning cached info
+const _MNT_NOWAIT = 2
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrNotSupported is returned by methods which are not supported on this system
+var ErrNotSupported = errors.New("Method is not supported on this system")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetUptime return system uptime in seconds
+func GetUptime() (uint64, error) {
+	uptime, err := GetUptimeDuration()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(uptime / time.Second), nil
+}
+
+// GetUptimeDuration return system uptime as duration
+func GetUptimeDuration() (time.Duration, error) {
+	bootTime, err := GetBootTime()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Since(bootTime), nil
+}
+
+// GetBootTime return system boot time
+func GetBootTime() (time.Time, error) {
+	data, err := sysctlRaw("kern.boottime", 16)
+
+	if err != nil {
+		return time.Time{}, errors.New("Can't read boot time info")
+	}
+
+	// struct timeval { time_t tv_sec; suseconds_t tv_usec; }
+	sec := int64(binary.LittleEndian.Uint64(data[0:8]))
+	usec := int64(int32(binary.LittleEndian.Uint32(data[8:12])))
+
+	return time.Unix(sec, usec*int64(time.Microsecond)), nil
+}
+
+// GetLA return loadavg (RProc and TProc are not available and always set to 0)
+func GetLA() (*LoadAvg, error) {
+	data, err := sysctlRaw("vm.loadavg", 24)
+
+	if err != nil {
+		return nil, errors.New("Can't read loadavg info")
+	}
+
+	// struct loadavg { fixpt_t ldavg[3]; long fscale; }
+	scale := float64(binary.LittleEndian.Uint64(data[16:24]))
+
+	if scale == 0 {
+		return nil, errors.New("Can't read loadavg info")
+	}
+
+	return &LoadAvg{
+		Min1:  float64(binary.LittleEndian.Uint32(data[0:4])) / scale,
+		Min5:  float64(binary.LittleEndian.Uint32(data[4:8])) / scale,
+		Min15: float64(binary.LittleEndian.Uint32(data[8:12])) / scale,
+	}, nil
+}
+
+// GetMemInfo return memory info (without cgo Active and Inactive
+// are not available and always set to 0)
+func GetMemInfo() (*MemInfo, error) {
+	memData, err := sysctlRaw("hw.memsize", 8)
+
+	if err != nil {
+		return nil, errors.New("Can't read memory info")
+	}
+
+	swapData, err := sysctlRaw("vm.swapusage", 32)
+
+	if err != nil {
+		return nil, errors.New("Can't read swap info")
+	}
+
+	stats, err := getVMStats()
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MemInfo{
+		MemTotal:  binary.LittleEndian.Uint64(memData[0:8]),
+		MemFree:   stats.Free + stats.Speculative,
+		Cached:    stats.External,
+		Active:    stats.Active,
+		Inactive:  stats.Inactive,
+		SwapTotal: binary.LittleEndian.Uint64(swapData[0:8]),
+		SwapFree:  binary.LittleEndian.Uint64(swapData[8:16]),
+		SwapUsed:  binary.LittleEndian.Uint64(swapData[16:24]),
+	}
+
+	result.MemFree += result.Cached
+	result.MemUsed = result.MemTotal - result.MemFree
+
+	return result, nil
+}
+
+// GetSwapDevices return info about swap devices
+// (not supported on this system)
+func GetSwapDevices() ([]*SwapDevice, error) {
+	return nil, ErrNotSupported
+}
+
+// GetCPUInfo return info about CPU usage (Wait is not available
+// and always set to 0). This method requires cgo, without cgo
+// ErrNotSupported is returned.
+func GetCPUInfo() (*CPUInfo, error) {
+	count, err := syscall.SysctlUint32("hw.ncpu")
+
+	if err != nil {
+		return nil, errors.New("Can't read CPU info")
+	}
+
+	user, nice, system, idle, err := getCPUTicks()
+
+	if err != nil {
+		return nil, err
+	}
+
+	total := user + nice + system + idle
+
+	if total == 0 {
+		return nil, errors.New("Can't read CPU info")
+	}
+
+	return &CPUInfo{
+		User:   (user / total) * 100,
+		Nice:   (nice / total) * 100,
+		System: (system / total) * 100,
+		Idle:   (idle / total) * 100,
+		Count:  int(count),
+	}, nil
+}
+
+// GetFSInfo return info about mounted filesystems (IOStats is not available
+// and always set to nil)
+func GetFSInfo() (map[string]*FSInfo, error) {
+	result := make(map[string]*FSInfo)
+
+	count, err := syscall.Getfsstat(nil, _MNT_NOWAIT)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]syscall.Statfs_t, count)
+	count, err = syscall.Getfsstat(stats, _MNT_NOWAIT)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stat := range stats[:count] {
+		device := int8SliceToString(stat.Mntfromname[:])
+
+		if device == "" || device[0:1] != "/" {
+			continue
+		}
+
+		fsInfo := &FSInfo{
+			Type:   int8SliceToString(stat.Fstypename[:]),
+			Device: device,
+			Total:  stat.Blocks * uint64(stat.Bsize),
+			Free:   stat.Bavail * uint64(stat.Bsize),
+		}
+
+		fsInfo.Used = fsInfo.Total - (stat.Bfree * uint64(stat.Bsize))
+
+		result[int8SliceToString(stat.Mntonname[:])] = fsInfo
+	}
+
+	return result, nil
+}
+
+// GetIOStats return IO statistics as map device -> statistics
+// (not supported on this system)
+func GetIOStats() (map[string]*IOStats, error) {
+	return nil, ErrNotSupported
+}
+
+// GetInterfacesInfo return info about network interfaces
+// (not supported on this system)
+func GetInterfacesInfo() (map[string]*InterfaceInfo, error) {
+	return nil, ErrNotSupported
+}
+
 // GetSystemInfo return system info
 func GetSystemInfo() (*SystemInfo, error) {
 	hostname, err := syscall.Sysctl("kern.hostname")
@@ -75,3 +273,36 @@ func getOSXVersion() string {
 
 	return string(versionData)
 }
+
+// sysctlRaw return raw sysctl value with given minimal size
+func sysctlRaw(name string, size int) ([]byte, error) {
+	value, err := syscall.Sysctl(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data := []byte(value)
+
+	// syscall.Sysctl removes trailing zero byte, so we must restore it
+	for len(data) < size {
+		data = append(data, 0)
+	}
+
+	return data, nil
+}
+
+// int8SliceToString convert C string to Go string
+func int8SliceToString(s []int8) string {
+	var result []byte
+
+	for _, r := range s {
+		if r == 0 {
+			break
+		}
+
+		result = append(result, byte(r))
+	}
+
+	return string(result)
+}
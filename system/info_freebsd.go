@@ -0,0 +1,297 @@
+// +build freebsd
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/binary"
+	"errors"
+	"syscall"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// _MNT_NOWAIT is getfsstat flag for returning cached info
+const _MNT_NOWAIT = 2
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrNotSupported is returned by methods which are not supported on this system
+var ErrNotSupported = errors.New("Method is not supported on this system")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetUptime return system uptime in seconds
+func GetUptime() (uint64, error) {
+	uptime, err := GetUptimeDuration()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(uptime / time.Second), nil
+}
+
+// GetUptimeDuration return system uptime as duration
+func GetUptimeDuration() (time.Duration, error) {
+	bootTime, err := GetBootTime()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Since(bootTime), nil
+}
+
+// GetBootTime return system boot time
+func GetBootTime() (time.Time, error) {
+	data, err := sysctlRaw("kern.boottime", 16)
+
+	if err != nil {
+		return time.Time{}, errors.New("Can't read boot time info")
+	}
+
+	// struct timeval { time_t tv_sec; suseconds_t tv_usec; }
+	sec := int64(binary.LittleEndian.Uint64(data[0:8]))
+	usec := int64(binary.LittleEndian.Uint64(data[8:16]))
+
+	return time.Unix(sec, usec*int64(time.Microsecond)), nil
+}
+
+// GetLA return loadavg (RProc and TProc are not available and always set to 0)
+func GetLA() (*LoadAvg, error) {
+	data, err := sysctlRaw("vm.loadavg", 24)
+
+	if err != nil {
+		return nil, errors.New("Can't read loadavg info")
+	}
+
+	// struct loadavg { fixpt_t ldavg[3]; long fscale; }
+	scale := float64(binary.LittleEndian.Uint64(data[16:24]))
+
+	if scale == 0 {
+		return nil, errors.New("Can't read loadavg info")
+	}
+
+	return &LoadAvg{
+		Min1:  float64(binary.LittleEndian.Uint32(data[0:4])) / scale,
+		Min5:  float64(binary.LittleEndian.Uint32(data[4:8])) / scale,
+		Min15: float64(binary.LittleEndian.Uint32(data[8:12])) / scale,
+	}, nil
+}
+
+// GetMemInfo return memory info (swap info is not available and always set to 0)
+func GetMemInfo() (*MemInfo, error) {
+	memData, err := sysctlRaw("hw.physmem", 8)
+
+	if err != nil {
+		return nil, errors.New("Can't read memory info")
+	}
+
+	pageSize, err := syscall.SysctlUint32("vm.stats.vm.v_page_size")
+
+	if err != nil {
+		return nil, errors.New("Can't read memory info")
+	}
+
+	stats := make(map[string]uint64)
+
+	for _, name := range []string{"v_free_count", "v_active_count", "v_inactive_count", "v_cache_count"} {
+		pages, err := syscall.SysctlUint32("vm.stats.vm." + name)
+
+		if err != nil {
+			return nil, errors.New("Can't read memory info")
+		}
+
+		stats[name] = uint64(pages) * uint64(pageSize)
+	}
+
+	result := &MemInfo{
+		MemTotal: binary.LittleEndian.Uint64(memData[0:8]),
+		MemFree:  stats["v_free_count"] + stats["v_cache_count"],
+		Cached:   stats["v_cache_count"],
+		Active:   stats["v_active_count"],
+		Inactive: stats["v_inactive_count"],
+	}
+
+	result.MemUsed = result.MemTotal - result.MemFree
+
+	return result, nil
+}
+
+// GetSwapDevices return info about swap devices
+// (not supported on this system)
+func GetSwapDevices() ([]*SwapDevice, error) {
+	return nil, ErrNotSupported
+}
+
+// GetCPUInfo return info about CPU usage (Wait is not available
+// and always set to 0)
+func GetCPUInfo() (*CPUInfo, error) {
+	count, err := syscall.SysctlUint32("hw.ncpu")
+
+	if err != nil {
+		return nil, errors.New("Can't read CPU info")
+	}
+
+	// long cp_time[CPUSTATES] { user, nice, sys, intr, idle }
+	data, err := sysctlRaw("kern.cp_time", 40)
+
+	if err != nil {
+		return nil, errors.New("Can't read CPU info")
+	}
+
+	var (
+		user   = float64(binary.LittleEndian.Uint64(data[0:8]))
+		nice   = float64(binary.LittleEndian.Uint64(data[8:16]))
+		system = float64(binary.LittleEndian.Uint64(data[16:24]))
+		intr   = float64(binary.LittleEndian.Uint64(data[24:32]))
+		idle   = float64(binary.LittleEndian.Uint64(data[32:40]))
+		total  = user + nice + system + intr + idle
+	)
+
+	if total == 0 {
+		return nil, errors.New("Can't read CPU info")
+	}
+
+	return &CPUInfo{
+		User:   (user / total) * 100,
+		Nice:   (nice / total) * 100,
+		System: ((system + intr) / total) * 100,
+		Idle:   (idle / total) * 100,
+		Count:  int(count),
+	}, nil
+}
+
+// GetFSInfo return info about mounted filesystems (IOStats is not available
+// and always set to nil)
+func GetFSInfo() (map[string]*FSInfo, error) {
+	result := make(map[string]*FSInfo)
+
+	count, err := syscall.Getfsstat(nil, _MNT_NOWAIT)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]syscall.Statfs_t, count)
+	count, err = syscall.Getfsstat(stats, _MNT_NOWAIT)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stat := range stats[:count] {
+		device := int8SliceToString(stat.Mntfromname[:])
+
+		if device == "" || device[0:1] != "/" {
+			continue
+		}
+
+		fsInfo := &FSInfo{
+			Type:   int8SliceToString(stat.Fstypename[:]),
+			Device: device,
+			Total:  stat.Blocks * stat.Bsize,
+		}
+
+		if stat.Bavail > 0 {
+			fsInfo.Free = uint64(stat.Bavail) * stat.Bsize
+		}
+
+		fsInfo.Used = fsInfo.Total - (stat.Bfree * stat.Bsize)
+
+		result[int8SliceToString(stat.Mntonname[:])] = fsInfo
+	}
+
+	return result, nil
+}
+
+// GetIOStats return IO statistics as map device -> statistics
+// (not supported on this system)
+func GetIOStats() (map[string]*IOStats, error) {
+	return nil, ErrNotSupported
+}
+
+// GetInterfacesInfo return info about network interfaces
+// (not supported on this system)
+func GetInterfacesInfo() (map[string]*InterfaceInfo, error) {
+	return nil, ErrNotSupported
+}
+
+// GetSystemInfo return system info
+func GetSystemInfo() (*SystemInfo, error) {
+	hostname, err := syscall.Sysctl("kern.hostname")
+
+	if err != nil || hostname == "" {
+		return nil, errors.New("Can't read hostname info")
+	}
+
+	os, err := syscall.Sysctl("kern.ostype")
+
+	if err != nil || os == "" {
+		return nil, errors.New("Can't read os info")
+	}
+
+	kernel, err := syscall.Sysctl("kern.osrelease")
+
+	if err != nil || kernel == "" {
+		return nil, errors.New("Can't read kernel info")
+	}
+
+	arch, err := syscall.Sysctl("hw.machine_arch")
+
+	if err != nil || arch == "" {
+		return nil, errors.New("Can't read arch info")
+	}
+
+	return &SystemInfo{
+		Hostname:     hostname,
+		OS:           os,
+		Distribution: os,
+		Version:      kernel,
+		Kernel:       kernel,
+		Arch:         arch,
+	}, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// sysctlRaw return raw sysctl value with given minimal size
+func sysctlRaw(name string, size int) ([]byte, error) {
+	value, err := syscall.Sysctl(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data := []byte(value)
+
+	// syscall.Sysctl removes trailing zero byte, so we must restore it
+	for len(data) < size {
+		data = append(data, 0)
+	}
+
+	return data, nil
+}
+
+// int8SliceToString convert C string to Go string
+func int8SliceToString(s []int8) string {
+	var result []byte
+
+	for _, r := range s {
+		if r == 0 {
+			break
+		}
+
+		result = append(result, byte(r))
+	}
+
+	return string(result)
+}
@@ -10,13 +10,400 @@ package system
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"errors"
 	"io/ioutil"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
+	"time"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+const (
+	_PROC_UPTIME    = "/proc/uptime"
+	_PROC_LOADAVG   = "/proc/loadavg"
+	_PROC_MEMINFO   = "/proc/meminfo"
+	_PROC_SWAPS     = "/proc/swaps"
+	_PROC_CPUINFO   = "/proc/stat"
+	_PROC_NET       = "/proc/net/dev"
+	_PROC_DISCSTATS = "/proc/diskstats"
+	_MTAB_FILE      = "/etc/mtab"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type basicCPUInfo struct {
+	User   uint64
+	Nice   uint64
+	System uint64
+	Idle   uint64
+	Wait   uint64
+	IRQ    uint64
+	SRQ    uint64
+	Steal  uint64
+	Total  uint64
+	Count  int
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetUptime return system uptime in seconds
+func GetUptime() (uint64, error) {
+	up, err := getUptimeSeconds()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(up), nil
+}
+
+// GetUptimeDuration return system uptime as duration
+func GetUptimeDuration() (time.Duration, error) {
+	up, err := getUptimeSeconds()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(up * float64(time.Second)), nil
+}
+
+// GetBootTime return system boot time
+func GetBootTime() (time.Time, error) {
+	content, err := readFileContent(_PROC_CPUINFO)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, line := range content {
+		if !strings.HasPrefix(line, "btime ") {
+			continue
+		}
+
+		btime, err := strconv.ParseInt(strings.TrimSpace(line[6:]), 10, 64)
+
+		if err != nil {
+			return time.Time{}, errors.New("Can't parse file " + _PROC_CPUINFO)
+		}
+
+		return time.Unix(btime, 0), nil
+	}
+
+	return time.Time{}, errors.New("Can't find boot time info in " + _PROC_CPUINFO)
+}
+
+// GetLA return loadavg
+func GetLA() (*LoadAvg, error) {
+	result := &LoadAvg{}
+	content, err := readFileContent(_PROC_LOADAVG)
+
+	if err != nil {
+		return nil, err
+	}
+
+	contentSlice := strings.Split(content[0], " ")
+
+	if len(contentSlice) != 5 {
+		return nil, errors.New("Can't parse file " + _PROC_LOADAVG)
+	}
+
+	procSlice := strings.Split(contentSlice[3], "/")
+
+	result.Min1, _ = strconv.ParseFloat(contentSlice[0], 64)
+	result.Min5, _ = strconv.ParseFloat(contentSlice[1], 64)
+	result.Min15, _ = strconv.ParseFloat(contentSlice[2], 64)
+	result.RProc, _ = strconv.Atoi(procSlice[0])
+	result.TProc, _ = strconv.Atoi(procSlice[1])
+
+	return result, nil
+}
+
+// GetMemInfo return memory info
+func GetMemInfo() (*MemInfo, error) {
+	var props = map[string]bool{
+		"MemTotal":   true,
+		"MemFree":    true,
+		"Buffers":    true,
+		"Cached":     true,
+		"SwapCached": true,
+		"Active":     true,
+		"Inactive":   true,
+		"SwapTotal":  true,
+		"SwapFree":   true,
+		"Dirty":      true,
+		"Slab":       true,
+	}
+
+	result := &MemInfo{}
+	content, err := readFileContent(_PROC_MEMINFO)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range content {
+		if line == "" {
+			continue
+		}
+
+		lineSlice := strings.Split(line, ":")
+
+		if len(lineSlice) != 2 {
+			return nil, errors.New("Can't parse file " + _PROC_MEMINFO)
+		}
+
+		if !props[lineSlice[0]] {
+			continue
+		}
+
+		strValue := strings.TrimRight(lineSlice[1], " kB")
+		strValue = strings.Replace(strValue, " ", "", -1)
+		uintValue, err := strconv.ParseUint(strValue, 10, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch lineSlice[0] {
+		case "MemTotal":
+			result.MemTotal = uintValue * 1024
+		case "MemFree":
+			result.MemFree = uintValue * 1024
+		case "Buffers":
+			result.Buffers = uintValue * 1024
+		case "Cached":
+			result.Cached = uintValue * 1024
+		case "SwapCached":
+			result.SwapCached = uintValue * 1024
+		case "Active":
+			result.Active = uintValue * 1024
+		case "Inactive":
+			result.Inactive = uintValue * 1024
+		case "SwapTotal":
+			result.SwapTotal = uintValue * 1024
+		case "SwapFree":
+			result.SwapFree = uintValue * 1024
+		case "Dirty":
+			result.Dirty = uintValue * 1024
+		case "Slab":
+			result.Slab = uintValue * 1024
+		}
+	}
+
+	result.MemFree += result.Cached + result.Buffers
+	result.MemUsed = result.MemTotal - result.MemFree
+	result.SwapUsed = result.SwapTotal - result.SwapFree
+
+	return result, nil
+}
+
+// GetSwapDevices return info about swap devices
+func GetSwapDevices() ([]*SwapDevice, error) {
+	var result []*SwapDevice
+
+	content, err := readFileContent(_PROC_SWAPS)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) <= 1 {
+		return result, nil
+	}
+
+	for _, line := range content[1:] {
+		if line == "" {
+			continue
+		}
+
+		values := cleanSlice(strings.Split(strings.Replace(line, "\t", " ", -1), " "))
+
+		if len(values) != 5 {
+			return nil, errors.New("Can't parse file " + _PROC_SWAPS)
+		}
+
+		size, _ := strconv.ParseUint(values[2], 10, 64)
+		used, _ := strconv.ParseUint(values[3], 10, 64)
+		priority, _ := strconv.Atoi(values[4])
+
+		result = append(result, &SwapDevice{
+			Path:     values[0],
+			Type:     values[1],
+			Size:     size * 1024,
+			Used:     used * 1024,
+			Priority: priority,
+		})
+	}
+
+	return result, nil
+}
+
+// GetCPUInfo return info about CPU usage
+func GetCPUInfo() (*CPUInfo, error) {
+	info, err := getCPUStats()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &CPUInfo{
+		System: (float64(info.System) / float64(info.Total)) * 100,
+		User:   (float64(info.User) / float64(info.Total)) * 100,
+		Nice:   (float64(info.Nice) / float64(info.Total)) * 100,
+		Wait:   (float64(info.Wait) / float64(info.Total)) * 100,
+		Idle:   (float64(info.Idle) / float64(info.Total)) * 100,
+		Count:  info.Count,
+	}, nil
+}
+
+// GetFSInfo return info about mounted filesystems
+func GetFSInfo() (map[string]*FSInfo, error) {
+	result := make(map[string]*FSInfo)
+
+	content, err := readFileContent(_MTAB_FILE)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ios, err := GetIOStats()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range content {
+		if line == "" || line[0:1] == "#" || line[0:1] != "/" {
+			continue
+		}
+
+		values := strings.Split(line, " ")
+
+		if len(values) < 4 {
+			return nil, errors.New("Can't parse file " + _MTAB_FILE)
+		}
+
+		path := values[1]
+		fsInfo := &FSInfo{Type: values[2]}
+		stats := &syscall.Statfs_t{}
+
+		err = syscall.Statfs(path, stats)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fsDevice, err := filepath.EvalSymlinks(values[0])
+
+		if err == nil {
+			fsInfo.Device = fsDevice
+		} else {
+			fsInfo.Device = values[0]
+		}
+
+		fsInfo.Total = stats.Blocks * uint64(stats.Bsize)
+		fsInfo.Free = uint64(stats.Bavail) * uint64(stats.Bsize)
+		fsInfo.Used = fsInfo.Total - (stats.Bfree * uint64(stats.Bsize))
+		fsInfo.IOStats = ios[strings.Replace(fsInfo.Device, "/dev/", "", 1)]
+
+		result[path] = fsInfo
+	}
+
+	return result, nil
+}
+
+// GetIOStats return IO statistics as map device -> statistics
+func GetIOStats() (map[string]*IOStats, error) {
+	result := make(map[string]*IOStats)
+
+	content, err := readFileContent(_PROC_DISCSTATS)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range content {
+		if line == "" {
+			continue
+		}
+
+		values := cleanSlice(strings.Split(line, " "))
+
+		if len(values) != 14 {
+			return nil, errors.New("Can't parse file " + _PROC_DISCSTATS)
+		}
+
+		device := values[2]
+
+		if len(device) > 3 {
+			if device[0:3] == "ram" || device[0:3] == "loo" {
+				continue
+			}
+		}
+
+		metrics := stringSliceToUintSlice(values[3:])
+
+		result[device] = &IOStats{
+			ReadComplete:  metrics[0],
+			ReadMerged:    metrics[1],
+			ReadSectors:   metrics[2],
+			ReadMs:        metrics[3],
+			WriteComplete: metrics[4],
+			WriteMerged:   metrics[5],
+			WriteSectors:  metrics[6],
+			WriteMs:       metrics[7],
+			IOPending:     metrics[8],
+			IOMs:          metrics[9],
+			IOQueueMs:     metrics[10],
+		}
+	}
+
+	return result, nil
+}
+
+// GetInterfacesInfo return info about network interfaces
+func GetInterfacesInfo() (map[string]*InterfaceInfo, error) {
+	result := make(map[string]*InterfaceInfo)
+
+	content, err := readFileContent(_PROC_NET)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) <= 2 {
+		return result, nil
+	}
+
+	for _, line := range content[2:] {
+		lineSlice := strings.Split(line, ":")
+
+		if len(lineSlice) != 2 {
+			continue
+		}
+
+		metrics := cleanSlice(strings.Split(lineSlice[1], " "))
+		name := strings.TrimLeft(lineSlice[0], " ")
+		receivedBytes, _ := strconv.ParseUint(metrics[0], 10, 64)
+		receivedPackets, _ := strconv.ParseUint(metrics[1], 10, 64)
+		transmittedBytes, _ := strconv.ParseUint(metrics[8], 10, 64)
+		transmittedPackets, _ := strconv.ParseUint(metrics[9], 10, 64)
+
+		result[name] = &InterfaceInfo{
+			receivedBytes,
+			receivedPackets,
+			transmittedBytes,
+			transmittedPackets,
+		}
+	}
+
+	return result, nil
+}
+
 // GetSystemInfo return system info
 func GetSystemInfo() (*SystemInfo, error) {
 	info := &syscall.Utsname{}
@@ -163,3 +550,55 @@ func getSuseVersion(file string) string {
 
 	return versionSlice[2] + "." + patchSlice[2]
 }
+
+// getUptimeSeconds return system uptime in seconds from /proc/uptime
+func getUptimeSeconds() (float64, error) {
+	content, err := readFileContent(_PROC_UPTIME)
+
+	if err != nil {
+		return 0, err
+	}
+
+	ca := strings.Split(content[0], " ")
+
+	if len(ca) != 2 {
+		return 0, errors.New("Can't parse file " + _PROC_UPTIME)
+	}
+
+	return strconv.ParseFloat(ca[0], 64)
+}
+
+// getCPUStats return basicCPUInfo
+func getCPUStats() (basicCPUInfo, error) {
+	content, err := readFileContent(_PROC_CPUINFO)
+
+	if err != nil || len(content) <= 1 {
+		return basicCPUInfo{}, errors.New("Can't parse file " + _PROC_CPUINFO)
+	}
+
+	result := basicCPUInfo{}
+
+	for _, line := range content {
+		if strings.HasPrefix(line, "cpu") {
+			result.Count++
+		}
+	}
+
+	result.Count--
+
+	cpu := strings.Replace(content[0], "cpu  ", "", -1)
+	cpua := strings.Split(cpu, " ")
+
+	result.User, _ = strconv.ParseUint(cpua[0], 10, 64)
+	result.Nice, _ = strconv.ParseUint(cpua[1], 10, 64)
+	result.System, _ = strconv.ParseUint(cpua[2], 10, 64)
+	result.Idle, _ = strconv.ParseUint(cpua[3], 10, 64)
+	result.Wait, _ = strconv.ParseUint(cpua[4], 10, 64)
+	result.IRQ, _ = strconv.ParseUint(cpua[5], 10, 64)
+	result.SRQ, _ = strconv.ParseUint(cpua[6], 10, 64)
+	result.Steal, _ = strconv.ParseUint(cpua[7], 10, 64)
+
+	result.Total = result.User + result.System + result.Nice + result.Idle + result.Wait + result.IRQ + result.SRQ + result.Steal
+
+	return result, nil
+}
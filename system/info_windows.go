@@ -9,6 +9,12 @@ package system
 //                                                                                    //
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+import (
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // LoadAvg contains information about average system load
 type LoadAvg struct {
 	Min1  float64 `json:"min1"`  // LA in last 1 minute
@@ -35,6 +41,15 @@ type MemInfo struct {
 	Slab       uint64 `json:"slab"`        // In-kernel data structures cache
 }
 
+// SwapDevice contains info about swap device or file
+type SwapDevice struct {
+	Path     string `json:"path"`     // Path to device or file
+	Type     string `json:"type"`     // Swap type (partition/file)
+	Size     uint64 `json:"size"`     // Total size
+	Used     uint64 `json:"used"`     // Used space
+	Priority int    `json:"priority"` // Swap priority
+}
+
 // CPUInfo contains info about CPU usage
 type CPUInfo struct {
 	User   float64 `json:"user"`   // Normal processes executing in user mode
@@ -93,6 +108,16 @@ func GetUptime() (uint64, error) {
 	return 0, nil
 }
 
+// GetUptimeDuration return system uptime as duration
+func GetUptimeDuration() (time.Duration, error) {
+	return 0, nil
+}
+
+// GetBootTime return system boot time
+func GetBootTime() (time.Time, error) {
+	return time.Time{}, nil
+}
+
 // GetLA return loadavg
 func GetLA() (*LoadAvg, error) {
 	return &LoadAvg{}, nil
@@ -103,6 +128,11 @@ func GetMemInfo() (*MemInfo, error) {
 	return &MemInfo{}, nil
 }
 
+// GetSwapDevices return info about swap devices
+func GetSwapDevices() ([]*SwapDevice, error) {
+	return []*SwapDevice{}, nil
+}
+
 // GetCPUInfo return info about CPU usage
 func GetCPUInfo() (*CPUInfo, error) {
 	return &CPUInfo{}, nil
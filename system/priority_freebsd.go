@@ -0,0 +1,59 @@
+// +build freebsd
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IO scheduling classes
+const (
+	IOPRIO_CLASS_NONE = 0 // No class set (priority is calculated from CPU nice level)
+	IOPRIO_CLASS_RT   = 1 // Real-time IO class
+	IOPRIO_CLASS_BE   = 2 // Best-effort IO class
+	IOPRIO_CLASS_IDLE = 3 // Idle IO class
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ErrIOPriorityNotSupported is returned by IO priority methods on systems
+// without IO scheduling classes support
+var ErrIOPriorityNotSupported = errors.New("IO priority is not supported on this system")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetNice return nice value of process with given pid (0 for current process)
+func GetNice(pid int) (int, error) {
+	return syscall.Getpriority(syscall.PRIO_PROCESS, pid)
+}
+
+// SetNice set nice value (-20..19) for process with given pid (0 for current process)
+func SetNice(pid, value int) error {
+	if value < -20 || value > 19 {
+		return errors.New("Nice value must be in range -20..19")
+	}
+
+	return syscall.Setpriority(syscall.PRIO_PROCESS, pid, value)
+}
+
+// GetIOPriority return IO scheduling class and priority level of process
+// with given pid (0 for current process)
+func GetIOPriority(pid int) (int, int, error) {
+	return 0, 0, ErrIOPriorityNotSupported
+}
+
+// SetIOPriority set IO scheduling class and priority level (0..7) for
+// process with given pid (0 for current process)
+func SetIOPriority(pid, class, level int) error {
+	return ErrIOPriorityNotSupported
+}
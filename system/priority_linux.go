@@ -0,0 +1,96 @@
+// +build linux
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IO scheduling classes
+const (
+	IOPRIO_CLASS_NONE = 0 // No class set (priority is calculated from CPU nice level)
+	IOPRIO_CLASS_RT   = 1 // Real-time IO class
+	IOPRIO_CLASS_BE   = 2 // Best-effort IO class
+	IOPRIO_CLASS_IDLE = 3 // Idle IO class
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const (
+	_IOPRIO_WHO_PROCESS = 1
+	_IOPRIO_CLASS_SHIFT = 13
+	_IOPRIO_PRIO_MASK   = (1 << _IOPRIO_CLASS_SHIFT) - 1
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetNice return nice value of process with given pid (0 for current process)
+func GetNice(pid int) (int, error) {
+	prio, err := syscall.Getpriority(syscall.PRIO_PROCESS, pid)
+
+	if err != nil {
+		return 0, err
+	}
+
+	// Linux getpriority syscall return value in range 40..1 instead of -20..19
+	return 20 - prio, nil
+}
+
+// SetNice set nice value (-20..19) for process with given pid (0 for current process)
+func SetNice(pid, value int) error {
+	if value < -20 || value > 19 {
+		return errors.New("Nice value must be in range -20..19")
+	}
+
+	return syscall.Setpriority(syscall.PRIO_PROCESS, pid, value)
+}
+
+// GetIOPriority return IO scheduling class and priority level of process
+// with given pid (0 for current process)
+func GetIOPriority(pid int) (int, int, error) {
+	prio, _, errno := syscall.Syscall(
+		syscall.SYS_IOPRIO_GET,
+		_IOPRIO_WHO_PROCESS, uintptr(pid), 0,
+	)
+
+	if errno != 0 {
+		return 0, 0, errno
+	}
+
+	return int(prio >> _IOPRIO_CLASS_SHIFT), int(prio & _IOPRIO_PRIO_MASK), nil
+}
+
+// SetIOPriority set IO scheduling class and priority level (0..7) for
+// process with given pid (0 for current process)
+func SetIOPriority(pid, class, level int) error {
+	if class < IOPRIO_CLASS_NONE || class > IOPRIO_CLASS_IDLE {
+		return errors.New("Unknown IO scheduling class")
+	}
+
+	if level < 0 || level > 7 {
+		return errors.New("IO priority level must be in range 0..7")
+	}
+
+	prio := class<<_IOPRIO_CLASS_SHIFT | level
+
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOPRIO_SET,
+		_IOPRIO_WHO_PROCESS, uintptr(pid), uintptr(prio),
+	)
+
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// +build !linux, !darwin, windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IO scheduling classes
+const (
+	IOPRIO_CLASS_NONE = 0 // No class set (priority is calculated from CPU nice level)
+	IOPRIO_CLASS_RT   = 1 // Real-time IO class
+	IOPRIO_CLASS_BE   = 2 // Best-effort IO class
+	IOPRIO_CLASS_IDLE = 3 // Idle IO class
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetNice return nice value of process with given pid (0 for current process)
+func GetNice(pid int) (int, error) {
+	return 0, nil
+}
+
+// SetNice set nice value (-20..19) for process with given pid (0 for current process)
+func SetNice(pid, value int) error {
+	return nil
+}
+
+// GetIOPriority return IO scheduling class and priority level of process
+// with given pid (0 for current process)
+func GetIOPriority(pid int) (int, int, error) {
+	return 0, 0, nil
+}
+
+// SetIOPriority set IO scheduling class and priority level (0..7) for
+// process with given pid (0 for current process)
+func SetIOPriority(pid, class, level int) error {
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -11,6 +11,7 @@ package process
 
 import (
 	"fmt"
+	"os"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -36,3 +37,34 @@ func Example_getList() {
 	// processes is slice with info about all active processes
 	fmt.Println(processes)
 }
+
+func ExampleGetProcessFDs() {
+	fds, err := GetProcessFDs(os.Getpid())
+
+	if err != nil {
+		return
+	}
+
+	// print info about all open descriptors
+	for _, fd := range fds {
+		fmt.Printf("%d -> %s\n", fd.FD, fd.Path)
+	}
+}
+
+func ExampleGetProcessConnections() {
+	connections, err := GetProcessConnections(os.Getpid())
+
+	if err != nil {
+		return
+	}
+
+	// print info about all network connections
+	for _, conn := range connections {
+		fmt.Printf(
+			"%s %s:%d -> %s:%d %s\n", conn.Protocol,
+			conn.LocalIP, conn.LocalPort,
+			conn.RemoteIP, conn.RemotePort,
+			conn.State,
+		)
+	}
+}
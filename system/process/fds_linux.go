@@ -0,0 +1,236 @@
+// +build linux
+
+package process
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// _SOCKET_PREFIX is prefix of fd link target for sockets
+const _SOCKET_PREFIX = "socket:["
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// FDInfo contains info about open file descriptor
+type FDInfo struct {
+	FD   int    // File descriptor number
+	Path string // Path to file or description of descriptor (socket:[inode], pipe:[inode]...)
+}
+
+// ConnectionInfo contains info about network connection
+type ConnectionInfo struct {
+	Protocol   string // Protocol (tcp/tcp6/udp/udp6)
+	LocalIP    net.IP // Local address
+	LocalPort  int    // Local port
+	RemoteIP   net.IP // Remote address
+	RemotePort int    // Remote port
+	State      string // Connection state (ESTABLISHED/LISTEN/etc...)
+	Inode      uint64 // Socket inode
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// tcpStates contains names of TCP states
+var tcpStates = map[string]string{
+	"01": "ESTABLISHED",
+	"02": "SYN_SENT",
+	"03": "SYN_RECV",
+	"04": "FIN_WAIT1",
+	"05": "FIN_WAIT2",
+	"06": "TIME_WAIT",
+	"07": "CLOSE",
+	"08": "CLOSE_WAIT",
+	"09": "LAST_ACK",
+	"0A": "LISTEN",
+	"0B": "CLOSING",
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsSocket return true if descriptor is socket
+func (fd *FDInfo) IsSocket() bool {
+	return strings.HasPrefix(fd.Path, _SOCKET_PREFIX)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetProcessFDs return slice with info about all open file descriptors
+// of process with given pid
+func GetProcessFDs(pid int) ([]*FDInfo, error) {
+	var result []*FDInfo
+
+	fdDir := "/proc/" + strconv.Itoa(pid) + "/fd"
+	fds, err := ioutil.ReadDir(fdDir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fd := range fds {
+		fdNum, err := strconv.Atoi(fd.Name())
+
+		if err != nil {
+			continue
+		}
+
+		path, err := os.Readlink(fdDir + "/" + fd.Name())
+
+		// Descriptor can be closed while we read the directory
+		if err != nil {
+			continue
+		}
+
+		result = append(result, &FDInfo{FD: fdNum, Path: path})
+	}
+
+	return result, nil
+}
+
+// GetProcessConnections return slice with info about all network connections
+// of process with given pid
+func GetProcessConnections(pid int) ([]*ConnectionInfo, error) {
+	var result []*ConnectionInfo
+
+	fds, err := GetProcessFDs(pid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	inodes := make(map[uint64]bool)
+
+	for _, fd := range fds {
+		if !fd.IsSocket() {
+			continue
+		}
+
+		inode, err := strconv.ParseUint(strings.Trim(fd.Path[len(_SOCKET_PREFIX):], "]"), 10, 64)
+
+		if err == nil {
+			inodes[inode] = true
+		}
+	}
+
+	if len(inodes) == 0 {
+		return result, nil
+	}
+
+	for _, protocol := range []string{"tcp", "tcp6", "udp", "udp6"} {
+		connections, err := readConnections(pid, protocol)
+
+		if err != nil {
+			continue
+		}
+
+		for _, conn := range connections {
+			if inodes[conn.Inode] {
+				result = append(result, conn)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// readConnections read info about all connections for given protocol from
+// network namespace of process with given pid
+func readConnections(pid int, protocol string) ([]*ConnectionInfo, error) {
+	var result []*ConnectionInfo
+
+	file := "/proc/" + strconv.Itoa(pid) + "/net/" + protocol
+	data, err := ioutil.ReadFile(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(data), "\n")
+
+	if len(lines) <= 1 {
+		return result, nil
+	}
+
+	for _, line := range lines[1:] {
+		values := strings.Fields(line)
+
+		if len(values) < 10 {
+			continue
+		}
+
+		localIP, localPort, err := parseSocketAddr(values[1])
+
+		if err != nil {
+			return nil, errors.New("Can't parse file " + file)
+		}
+
+		remoteIP, remotePort, err := parseSocketAddr(values[2])
+
+		if err != nil {
+			return nil, errors.New("Can't parse file " + file)
+		}
+
+		inode, _ := strconv.ParseUint(values[9], 10, 64)
+
+		conn := &ConnectionInfo{
+			Protocol:   protocol,
+			LocalIP:    localIP,
+			LocalPort:  localPort,
+			RemoteIP:   remoteIP,
+			RemotePort: remotePort,
+			Inode:      inode,
+		}
+
+		if strings.HasPrefix(protocol, "tcp") {
+			conn.State = tcpStates[values[3]]
+		}
+
+		result = append(result, conn)
+	}
+
+	return result, nil
+}
+
+// parseSocketAddr parse address in kernel format (0100007F:0050)
+func parseSocketAddr(addr string) (net.IP, int, error) {
+	addrSlice := strings.Split(addr, ":")
+
+	if len(addrSlice) != 2 {
+		return nil, 0, errors.New("Wrong address format")
+	}
+
+	ipData, err := hex.DecodeString(addrSlice[0])
+
+	if err != nil || (len(ipData) != net.IPv4len && len(ipData) != net.IPv6len) {
+		return nil, 0, errors.New("Wrong address format")
+	}
+
+	port, err := strconv.ParseUint(addrSlice[1], 16, 16)
+
+	if err != nil {
+		return nil, 0, errors.New("Wrong port format")
+	}
+
+	// Address is stored as array of 32-bit words in host (little-endian) byte order
+	for i := 0; i < len(ipData); i += 4 {
+		ipData[i], ipData[i+1], ipData[i+2], ipData[i+3] = ipData[i+3], ipData[i+2], ipData[i+1], ipData[i]
+	}
+
+	return net.IP(ipData), int(port), nil
+}
@@ -9,6 +9,12 @@ package process
 //                                                                                    //
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+import (
+	"net"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 // ProcessInfo contains basic info about process
 type ProcessInfo struct {
 	Command  string         // Full command
@@ -19,6 +25,30 @@ type ProcessInfo struct {
 	Childs   []*ProcessInfo // Slice with child processes
 }
 
+// FDInfo contains info about open file descriptor
+type FDInfo struct {
+	FD   int    // File descriptor number
+	Path string // Path to file or description of descriptor (socket:[inode], pipe:[inode]...)
+}
+
+// ConnectionInfo contains info about network connection
+type ConnectionInfo struct {
+	Protocol   string // Protocol (tcp/tcp6/udp/udp6)
+	LocalIP    net.IP // Local address
+	LocalPort  int    // Local port
+	RemoteIP   net.IP // Remote address
+	RemotePort int    // Remote port
+	State      string // Connection state (ESTABLISHED/LISTEN/etc...)
+	Inode      uint64 // Socket inode
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// IsSocket return true if descriptor is socket
+func (fd *FDInfo) IsSocket() bool {
+	return false
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // GetTree return root process with all subprocesses on system
@@ -30,3 +60,15 @@ func GetTree(pid ...int) (*ProcessInfo, error) {
 func GetList() ([]*ProcessInfo, error) {
 	return nil, nil
 }
+
+// GetProcessFDs return slice with info about all open file descriptors
+// of process with given pid
+func GetProcessFDs(pid int) ([]*FDInfo, error) {
+	return nil, nil
+}
+
+// GetProcessConnections return slice with info about all network connections
+// of process with given pid
+func GetProcessConnections(pid int) ([]*ConnectionInfo, error) {
+	return nil, nil
+}
@@ -0,0 +1,102 @@
+// +build linux, darwin, !windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const (
+	_RESOLV_CONF_FILE   = "/etc/resolv.conf"
+	_NSSWITCH_CONF_FILE = "/etc/nsswitch.conf"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ResolverInfo contains info about resolver configuration
+type ResolverInfo struct {
+	Nameservers []string `json:"nameservers"`  // Nameservers addresses
+	Domain      string   `json:"domain"`       // Local domain name
+	Search      []string `json:"search"`       // Search list for host-name lookup
+	Options     []string `json:"options"`      // Resolver options
+	HostsLookup []string `json:"hosts_lookup"` // Sources for hosts lookup from nsswitch.conf
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetResolverInfo return info about resolver configuration
+func GetResolverInfo() (*ResolverInfo, error) {
+	content, err := readFileContent(_RESOLV_CONF_FILE)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ResolverInfo{}
+
+	for _, line := range content {
+		values := strings.Fields(stripConfComment(line))
+
+		if len(values) < 2 {
+			continue
+		}
+
+		switch values[0] {
+		case "nameserver":
+			result.Nameservers = append(result.Nameservers, values[1])
+		case "domain":
+			result.Domain = values[1]
+		case "search":
+			result.Search = values[1:]
+		case "options":
+			result.Options = append(result.Options, values[1:]...)
+		}
+	}
+
+	result.HostsLookup = getHostsLookupSources()
+
+	return result, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// getHostsLookupSources return sources for hosts lookup from nsswitch.conf
+func getHostsLookupSources() []string {
+	content, err := readFileContent(_NSSWITCH_CONF_FILE)
+
+	if err != nil {
+		return nil
+	}
+
+	for _, line := range content {
+		line = stripConfComment(line)
+
+		if !strings.HasPrefix(line, "hosts:") {
+			continue
+		}
+
+		return strings.Fields(line[6:])
+	}
+
+	return nil
+}
+
+// stripConfComment remove comment from config line
+func stripConfComment(line string) string {
+	index := strings.IndexAny(line, "#;")
+
+	if index == -1 {
+		return line
+	}
+
+	return line[:index]
+}
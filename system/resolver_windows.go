@@ -0,0 +1,28 @@
+// +build !linux, !darwin, windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ResolverInfo contains info about resolver configuration
+type ResolverInfo struct {
+	Nameservers []string `json:"nameservers"`  // Nameservers addresses
+	Domain      string   `json:"domain"`       // Local domain name
+	Search      []string `json:"search"`       // Search list for host-name lookup
+	Options     []string `json:"options"`      // Resolver options
+	HostsLookup []string `json:"hosts_lookup"` // Sources for hosts lookup from nsswitch.conf
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GetResolverInfo return info about resolver configuration
+func GetResolverInfo() (*ResolverInfo, error) {
+	return &ResolverInfo{}, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
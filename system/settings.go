@@ -0,0 +1,116 @@
+// +build linux, darwin, !windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const (
+	_ZONEINFO_DIR   = "/usr/share/zoneinfo"
+	_LOCALTIME_FILE = "/etc/localtime"
+	_TIMEZONE_FILE  = "/etc/timezone"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SetTimezone change system timezone (e.g. "Europe/Moscow"). Timezone must
+// exist in tzdata directory.
+func SetTimezone(tz string) error {
+	err := validateTimezone(tz)
+
+	if err != nil {
+		return err
+	}
+
+	tzFile := _ZONEINFO_DIR + "/" + tz
+	tmpLink := _LOCALTIME_FILE + ".tmp"
+
+	os.Remove(tmpLink)
+
+	err = os.Symlink(tzFile, tmpLink)
+
+	if err != nil {
+		return err
+	}
+
+	// Rename is atomic, so /etc/localtime is always valid
+	err = os.Rename(tmpLink, _LOCALTIME_FILE)
+
+	if err != nil {
+		os.Remove(tmpLink)
+		return err
+	}
+
+	// Some distributions (Debian/Ubuntu) also store timezone name in /etc/timezone
+	if isFileExist(_TIMEZONE_FILE) {
+		return ioutil.WriteFile(_TIMEZONE_FILE, []byte(tz+"\n"), 0644)
+	}
+
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// validateHostname check hostname for RFC 1123 compliance
+func validateHostname(name string) error {
+	switch {
+	case name == "":
+		return errors.New("Hostname can't be blank")
+	case len(name) > 64:
+		return errors.New("Hostname is too long (max 64 symbols)")
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 {
+			return errors.New("Hostname " + name + " is not valid")
+		}
+
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return errors.New("Hostname " + name + " is not valid")
+		}
+
+		for _, r := range label {
+			switch {
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z',
+				r >= '0' && r <= '9', r == '-':
+				continue
+			default:
+				return errors.New("Hostname " + name + " contains invalid symbols")
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateTimezone check that timezone exist in tzdata directory
+func validateTimezone(tz string) error {
+	switch {
+	case tz == "":
+		return errors.New("Timezone can't be blank")
+	case filepath.IsAbs(tz), strings.Contains(tz, ".."):
+		return errors.New("Timezone " + tz + " is not valid")
+	}
+
+	info, err := os.Stat(_ZONEINFO_DIR + "/" + tz)
+
+	if err != nil || !info.Mode().IsRegular() {
+		return errors.New("Timezone " + tz + " does not exist")
+	}
+
+	return nil
+}
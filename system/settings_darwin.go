@@ -0,0 +1,27 @@
+// +build darwin
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os/exec"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SetHostname change system hostname
+func SetHostname(name string) error {
+	err := validateHostname(name)
+
+	if err != nil {
+		return err
+	}
+
+	return exec.Command("scutil", "--set", "HostName", name).Run()
+}
@@ -0,0 +1,28 @@
+// +build freebsd
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"os/exec"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SetHostname change system hostname. New hostname will not be saved
+// to rc.conf, so it will be reset after reboot.
+func SetHostname(name string) error {
+	err := validateHostname(name)
+
+	if err != nil {
+		return err
+	}
+
+	return exec.Command("hostname", name).Run()
+}
@@ -0,0 +1,43 @@
+// +build linux
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"io/ioutil"
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const _HOSTNAME_FILE = "/etc/hostname"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SetHostname change system hostname. If /etc/hostname exist, new
+// hostname also will be saved to this file.
+func SetHostname(name string) error {
+	err := validateHostname(name)
+
+	if err != nil {
+		return err
+	}
+
+	err = syscall.Sethostname([]byte(name))
+
+	if err != nil {
+		return err
+	}
+
+	if isFileExist(_HOSTNAME_FILE) {
+		return ioutil.WriteFile(_HOSTNAME_FILE, []byte(name+"\n"), 0644)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+// +build !linux, !darwin, windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SetHostname change system hostname
+func SetHostname(name string) error {
+	return nil
+}
+
+// SetTimezone change system timezone
+func SetTimezone(tz string) error {
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
@@ -0,0 +1,102 @@
+// +build linux, darwin, !windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"syscall"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// _ALIVE_CHECK_DELAY is delay between process state checks while waiting
+// for process termination
+const _ALIVE_CHECK_DELAY = 25 * time.Millisecond
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SendSignal send signal to process with given pid
+func SendSignal(pid int, sig syscall.Signal) error {
+	if pid <= 0 {
+		return errors.New("PID must be greater than 0")
+	}
+
+	return syscall.Kill(pid, sig)
+}
+
+// SendSignalToGroup send signal to all processes in group with given pgid
+func SendSignalToGroup(pgid int, sig syscall.Signal) error {
+	if pgid <= 0 {
+		return errors.New("Process group ID must be greater than 0")
+	}
+
+	return syscall.Kill(-pgid, sig)
+}
+
+// IsProcessAlive check if process with given pid exist
+func IsProcessAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	err := syscall.Kill(pid, syscall.Signal(0))
+
+	// EPERM means that process exist, but we don't have
+	// permissions for sending signals to it
+	return err == nil || err == syscall.EPERM
+}
+
+// TerminateProcess send TERM signal to process and wait until process
+// will be stopped. If process is still alive after timeout, KILL signal
+// will be sent.
+func TerminateProcess(pid int, timeout time.Duration) error {
+	err := SendSignal(pid, syscall.SIGTERM)
+
+	if err != nil {
+		return err
+	}
+
+	if waitProcessExit(pid, timeout) {
+		return nil
+	}
+
+	err = SendSignal(pid, syscall.SIGKILL)
+
+	if err != nil && err != syscall.ESRCH {
+		return err
+	}
+
+	if !waitProcessExit(pid, time.Second) {
+		return errors.New("Process is still alive after KILL signal")
+	}
+
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// waitProcessExit wait until process stop or timeout is reached, return
+// true if process was stopped
+func waitProcessExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		if !IsProcessAlive(pid) {
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			return false
+		}
+
+		time.Sleep(_ALIVE_CHECK_DELAY)
+	}
+}
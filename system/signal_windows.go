@@ -0,0 +1,40 @@
+// +build !linux, !darwin, windows
+
+package system
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"syscall"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SendSignal send signal to process with given pid
+func SendSignal(pid int, sig syscall.Signal) error {
+	return nil
+}
+
+// SendSignalToGroup send signal to all processes in group with given pgid
+func SendSignalToGroup(pgid int, sig syscall.Signal) error {
+	return nil
+}
+
+// IsProcessAlive check if process with given pid exist
+func IsProcessAlive(pid int) bool {
+	return false
+}
+
+// TerminateProcess send TERM signal to process and wait until process
+// will be stopped
+func TerminateProcess(pid int, timeout time.Duration) error {
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
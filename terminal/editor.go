@@ -0,0 +1,81 @@
+// +build !windows
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+
+	"pkg.re/essentialkaos/ek.v7/tmp"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DefaultEditor is editor used by ReadEditor if EDITOR env variable is empty
+var DefaultEditor = "vi"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ReadEditor open editor from EDITOR env variable with temporary file which
+// contains initial text and return text saved by user
+func ReadEditor(initial string) (string, error) {
+	if NonInteractive {
+		return "", ErrNonInteractive
+	}
+
+	temp, err := tmp.NewTemp(os.TempDir())
+
+	if err != nil {
+		return "", err
+	}
+
+	defer temp.Clean()
+
+	fd, file, err := temp.MkFile("edit.txt")
+
+	if err != nil {
+		return "", err
+	}
+
+	_, err = fd.WriteString(initial)
+	fd.Close()
+
+	if err != nil {
+		return "", err
+	}
+
+	editor := os.Getenv("EDITOR")
+
+	if editor == "" {
+		editor = DefaultEditor
+	}
+
+	// Editor command can contain some options, so we run it using shell
+	cmd := exec.Command("/bin/sh", "-c", editor+` "$1"`, "editor", file)
+
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Run()
+
+	if err != nil {
+		return "", err
+	}
+
+	data, err := ioutil.ReadFile(file)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,46 @@
+// +build go1.7
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func ExampleReadUIContext() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// if user doesn't finish input in 30 seconds, ErrTimeout will be returned
+	input, err := ReadUIContext(ctx, "Please enter user name", true)
+
+	if err == ErrTimeout {
+		fmt.Println("No input from user")
+		return
+	}
+
+	fmt.Printf("User name: %s\n", input)
+}
+
+func ExampleReadAnswerContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ok, err := ReadAnswerContext(ctx, "Remove this file?", "N")
+
+	if err != nil || !ok {
+		return
+	}
+
+	fmt.Println("File removed")
+}
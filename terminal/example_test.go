@@ -8,7 +8,11 @@ package terminal
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -41,6 +45,86 @@ func ExampleReadPassword() {
 	fmt.Printf("User password: %s\v", input)
 }
 
+func ExampleMaskMode() {
+	// show last typed symbol for a second and mask it after that
+	MaskMode = MASK_MODE_SHOW_LAST
+	MaskShowDelay = time.Second
+
+	password, err := ReadPassword("Please enter password", true)
+
+	if err != nil {
+		return
+	}
+
+	fmt.Printf("Password length: %d\n", len(password))
+}
+
+func ExampleMaskRenderer() {
+	// don't show real length of password
+	MaskRenderer = func(input string) string {
+		if input == "" {
+			return ""
+		}
+
+		return "********"
+	}
+
+	MaskMode = MASK_MODE_SYMBOLS
+
+	ReadPassword("Please enter password", true)
+}
+
+func ExampleReadConfirmation() {
+	ok, err := ReadConfirmation("Type \"production-db\" to confirm removal", "production-db")
+
+	if err != nil || !ok {
+		PrintErrorMessage("Removal canceled")
+		return
+	}
+
+	fmt.Println("Database removed")
+}
+
+func ExampleReadPasswordConfirm() {
+	// user will be asked for password twice, strength of
+	// password will be shown after first input
+	password, err := ReadPasswordConfirm("Please enter new password", true)
+
+	if err == ErrPasswordMismatch {
+		PrintErrorMessage("Passwords don't match")
+		return
+	}
+
+	if err != nil {
+		return
+	}
+
+	fmt.Printf("Password length: %d\n", len(password))
+}
+
+func ExampleReadML() {
+	// input will be finished when user enter line with single dot
+	MLTerminator = "."
+
+	text, err := ReadML("Please enter description (enter \".\" to finish)")
+
+	if err != nil {
+		return
+	}
+
+	fmt.Printf("Description: %s\n", text)
+}
+
+func ExampleReadEditor() {
+	message, err := ReadEditor("\n# Please enter commit message\n")
+
+	if err != nil {
+		return
+	}
+
+	fmt.Printf("Commit message: %s\n", message)
+}
+
 func ExampleReadAnswer() {
 
 	// is user doesn't enter any value, we use default value (Y in this case)
@@ -55,6 +139,49 @@ func ExampleReadAnswer() {
 	}
 }
 
+func ExampleReadUITimeout() {
+	// if user doesn't finish input in 30 seconds, ErrTimeout will be returned
+	input, err := ReadUITimeout("Please enter user name", true, 30*time.Second)
+
+	if err == ErrTimeout {
+		fmt.Println("No input from user")
+		return
+	}
+
+	fmt.Printf("User name: %s\n", input)
+}
+
+func ExampleReadAnswerTimeout() {
+	ok, err := ReadAnswerTimeout("Remove this file?", "N", 30*time.Second)
+
+	if err != nil || !ok {
+		return
+	}
+
+	fmt.Println("File removed")
+}
+
+func ExamplePrintInfoMessage() {
+	PrintInfoMessage("Configuration file: %s", "/etc/myapp.conf")
+}
+
+func ExamplePrintSuccessMessage() {
+	PrintSuccessMessage("Everything is fine!")
+}
+
+func ExampleTheme() {
+	// change colors used for terminal output
+	CurrentTheme = Theme{
+		Error:   "{r*}",
+		Warn:    "{m}",
+		Info:    "{b}",
+		Success: "{g*}",
+		Prompt:  "{y*}",
+	}
+
+	PrintWarnMessage("Configuration file is deprecated")
+}
+
 func ExamplePrintActionMessage() {
 	statusOk := true
 
@@ -90,3 +217,182 @@ func ExamplePrintWarnMessage() {
 	// print this text with yellow color
 	PrintWarnMessage("Warning file is not found")
 }
+
+func ExampleStartAction() {
+	// spinner will be animated while action is in progress
+	StartAction("Downloading data")
+
+	err := errors.New("Connection timeout")
+
+	if err != nil {
+		StopAction(1) // Print ERROR
+		return
+	}
+
+	StopAction(0) // Print OK
+}
+
+func ExampleReadKey() {
+	fmt.Println("Press any arrow key...")
+
+	for {
+		key, _, err := ReadKey()
+
+		if err != nil {
+			return
+		}
+
+		switch key {
+		case KEY_UP, KEY_DOWN, KEY_LEFT, KEY_RIGHT:
+			fmt.Println("Thanks!")
+			return
+		case KEY_ESC:
+			return
+		}
+	}
+}
+
+func ExampleReadRune() {
+	fmt.Println("Continue? (y/n)")
+
+	r, err := ReadRune()
+
+	if err != nil || r != 'y' {
+		return
+	}
+
+	fmt.Println("Continuing...")
+}
+
+func ExampleReadSelect() {
+	options := []string{"Debian", "CentOS", "Fedora", "Arch"}
+
+	// user can navigate with arrow keys and filter options by typing
+	index, err := ReadSelect("Please select distribution", options)
+
+	if err != nil {
+		return
+	}
+
+	fmt.Printf("Selected distribution: %s\n", options[index])
+}
+
+func ExampleReadMultiSelect() {
+	features := []string{"Docs", "Examples", "Debug symbols"}
+
+	// first option is selected by default
+	indexes, err := ReadMultiSelect("Select features for install", features, []int{0})
+
+	if err != nil {
+		return
+	}
+
+	for _, index := range indexes {
+		fmt.Printf("Installing %s...\n", features[index])
+	}
+}
+
+func ExampleIsTTY() {
+	if !IsTTY() {
+		fmt.Println("Output is redirected")
+	}
+}
+
+func ExampleNonInteractive() {
+	// in non-interactive mode ReadAnswer returns default answer without
+	// asking user, other input methods return ErrNonInteractive
+	NonInteractive = true
+
+	ok, _ := ReadAnswer("Remove this file?", "Y")
+
+	if !ok {
+		return
+	}
+
+	_, err := ReadUI("Please enter file name", true)
+
+	if err == ErrNonInteractive {
+		fmt.Println("Can't ask user for file name")
+	}
+}
+
+func ExampleSetStatus() {
+	files := []string{"file1.txt", "file2.txt", "file3.txt"}
+
+	for index, file := range files {
+		// status line will be shown at the bottom of terminal window
+		SetStatus("{s}Processing file %d of %d{!}", index+1, len(files))
+		fmt.Printf("File %s processed\n", file)
+	}
+
+	ClearStatus()
+}
+
+func ExamplePage() {
+	var content string
+
+	for i := 1; i <= 100; i++ {
+		content += fmt.Sprintf("Line %d\n", i)
+	}
+
+	// if content doesn't fit into terminal window, it will be shown
+	// using pager from PAGER env variable or internal pager
+	Page(content)
+}
+
+func ExamplePageReader() {
+	fd, err := os.Open("/var/log/messages")
+
+	if err != nil {
+		return
+	}
+
+	defer fd.Close()
+
+	PageReader(fd)
+}
+
+func ExamplePrintWrapped() {
+	PrintWrapped(
+		"{*}Warning:{!} this command will remove all data from the database and "+
+			"this action can't be undone. Please make sure that you have "+
+			"backup before running this command.", 2,
+	)
+}
+
+func ExampleStrip() {
+	fmt.Println(Strip("{r*}Error:{!} \033[1mfile not found\033[0m"))
+
+	// Output: Error: file not found
+}
+
+func ExampleWidth() {
+	fmt.Println(Width("{g}OK{!}"))
+	fmt.Println(Width("日本語"))
+
+	// Output:
+	// 2
+	// 6
+}
+
+func ExampleValidator() {
+	// custom validator
+	isPort := func(input string) (string, error) {
+		port, err := strconv.Atoi(input)
+
+		if err != nil || port <= 0 || port > 65535 {
+			return input, errors.New("Port must be a number in range 1-65535")
+		}
+
+		return input, nil
+	}
+
+	// user will be asked for input until valid port number will be entered
+	input, err := ReadUI("Please enter port number", true, IsNumber, isPort)
+
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+	}
+
+	fmt.Printf("Port: %s\n", input)
+}
@@ -0,0 +1,150 @@
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"unicode/utf8"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Key is code of pressed key
+type Key int
+
+// Key codes
+const (
+	KEY_UNKNOWN Key = iota // Unknown key or escape sequence
+	KEY_RUNE               // Printable symbol
+	KEY_ENTER
+	KEY_TAB
+	KEY_BACKSPACE
+	KEY_ESC
+	KEY_UP
+	KEY_DOWN
+	KEY_LEFT
+	KEY_RIGHT
+	KEY_HOME
+	KEY_END
+	KEY_PGUP
+	KEY_PGDN
+	KEY_INSERT
+	KEY_DELETE
+	KEY_CTRL_A
+	KEY_CTRL_B
+	KEY_CTRL_C
+	KEY_CTRL_D
+	KEY_CTRL_E
+	KEY_CTRL_F
+	KEY_CTRL_G
+	KEY_CTRL_H // Not returned by ReadKey (same code as KEY_BACKSPACE)
+	KEY_CTRL_I // Not returned by ReadKey (same code as KEY_TAB)
+	KEY_CTRL_J // Not returned by ReadKey (same code as KEY_ENTER)
+	KEY_CTRL_K
+	KEY_CTRL_L
+	KEY_CTRL_M // Not returned by ReadKey (same code as KEY_ENTER)
+	KEY_CTRL_N
+	KEY_CTRL_O
+	KEY_CTRL_P
+	KEY_CTRL_Q
+	KEY_CTRL_R
+	KEY_CTRL_S
+	KEY_CTRL_T
+	KEY_CTRL_U
+	KEY_CTRL_V
+	KEY_CTRL_W
+	KEY_CTRL_X
+	KEY_CTRL_Y
+	KEY_CTRL_Z
+	KEY_F1
+	KEY_F2
+	KEY_F3
+	KEY_F4
+	KEY_F5
+	KEY_F6
+	KEY_F7
+	KEY_F8
+	KEY_F9
+	KEY_F10
+	KEY_F11
+	KEY_F12
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// escSequences contains key codes for escape sequences
+var escSequences = map[string]Key{
+	"[A": KEY_UP, "OA": KEY_UP,
+	"[B": KEY_DOWN, "OB": KEY_DOWN,
+	"[C": KEY_RIGHT, "OC": KEY_RIGHT,
+	"[D": KEY_LEFT, "OD": KEY_LEFT,
+	"[H": KEY_HOME, "OH": KEY_HOME, "[1~": KEY_HOME, "[7~": KEY_HOME,
+	"[F": KEY_END, "OF": KEY_END, "[4~": KEY_END, "[8~": KEY_END,
+	"[2~": KEY_INSERT,
+	"[3~": KEY_DELETE,
+	"[5~": KEY_PGUP,
+	"[6~": KEY_PGDN,
+
+	"OP": KEY_F1, "[11~": KEY_F1, "[[A": KEY_F1,
+	"OQ": KEY_F2, "[12~": KEY_F2, "[[B": KEY_F2,
+	"OR": KEY_F3, "[13~": KEY_F3, "[[C": KEY_F3,
+	"OS": KEY_F4, "[14~": KEY_F4, "[[D": KEY_F4,
+	"[15~": KEY_F5, "[[E": KEY_F5,
+	"[17~": KEY_F6,
+	"[18~": KEY_F7,
+	"[19~": KEY_F8,
+	"[20~": KEY_F9,
+	"[21~": KEY_F10,
+	"[23~": KEY_F11,
+	"[24~": KEY_F12,
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseKey convert raw input data to key code and symbol
+func parseKey(data []byte) (Key, rune) {
+	if len(data) == 0 {
+		return KEY_UNKNOWN, 0
+	}
+
+	switch data[0] {
+	case 0x1B:
+		if len(data) == 1 {
+			return KEY_ESC, 0
+		}
+
+		key, ok := escSequences[string(data[1:])]
+
+		if !ok {
+			return KEY_UNKNOWN, 0
+		}
+
+		return key, 0
+	case '\r', '\n':
+		return KEY_ENTER, 0
+	case '\t':
+		return KEY_TAB, 0
+	case 0x7F, 0x08:
+		return KEY_BACKSPACE, 0
+	}
+
+	if data[0] < 0x20 {
+		if data[0] >= 0x01 && data[0] <= 0x1A {
+			return KEY_CTRL_A + Key(data[0]-0x01), 0
+		}
+
+		return KEY_UNKNOWN, 0
+	}
+
+	r, _ := utf8.DecodeRune(data)
+
+	if r == utf8.RuneError {
+		return KEY_UNKNOWN, 0
+	}
+
+	return KEY_RUNE, r
+}
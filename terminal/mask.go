@@ -0,0 +1,195 @@
+// +build !windows
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Private input masking modes
+const (
+	// MASK_MODE_DEFAULT input is shown while typing and masked after pressing Enter
+	MASK_MODE_DEFAULT = iota
+
+	// MASK_MODE_SYMBOLS every typed symbol is replaced by mask symbol
+	MASK_MODE_SYMBOLS
+
+	// MASK_MODE_SHOW_LAST last typed symbol is shown for MaskShowDelay and
+	// replaced by mask symbol after that
+	MASK_MODE_SHOW_LAST
+
+	// MASK_MODE_HIDDEN nothing is shown while typing
+	MASK_MODE_HIDDEN
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// MaskMode is masking mode used for private input
+var MaskMode = MASK_MODE_DEFAULT
+
+// MaskShowDelay is delay before last typed symbol will be masked in
+// MASK_MODE_SHOW_LAST mode
+var MaskShowDelay = 500 * time.Millisecond
+
+// MaskRenderer is custom function for rendering masked input. If set, it will
+// be used instead of MaskSymbol.
+var MaskRenderer func(input string) string
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// readRawLine read line in raw mode. If masked is true, input is masked
+// according to MaskMode. If limit is set, input must be finished before
+// limit deadline.
+func readRawLine(masked bool, limit *inputLimit) (string, error) {
+	fd := os.Stdin.Fd()
+	state, err := enableRawMode(fd)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer restoreMode(fd, state)
+
+	var input []rune
+
+	buf := make([]byte, 256)
+
+	renderRawInput(input, masked, false)
+
+	for {
+		if limit != nil {
+			err = limit.wait()
+
+			if err != nil {
+				fmt.Print("\r\n")
+				return "", err
+			}
+		}
+
+		n, err := syscall.Read(int(fd), buf)
+
+		if err != nil {
+			return "", err
+		}
+
+		if n == 0 {
+			return "", ErrKillSignal
+		}
+
+		key, _ := parseKey(buf[:n])
+		showLast := false
+
+		switch key {
+		case KEY_ENTER:
+			renderRawInput(input, masked, false)
+			fmt.Print("\r\n")
+			return string(input), nil
+
+		case KEY_CTRL_C, KEY_CTRL_D:
+			fmt.Print("\r\n")
+			return "", ErrKillSignal
+
+		case KEY_BACKSPACE:
+			if len(input) != 0 {
+				input = input[:len(input)-1]
+			}
+
+		case KEY_CTRL_U:
+			input = nil
+
+		case KEY_RUNE:
+			// Data can contain more than one symbol if text was pasted
+			for data := buf[:n]; len(data) != 0; {
+				r, size := utf8.DecodeRune(data)
+				data = data[size:]
+
+				if r == '\r' || r == '\n' {
+					renderRawInput(input, masked, false)
+					fmt.Print("\r\n")
+					return string(input), nil
+				}
+
+				if r != utf8.RuneError && unicode.IsPrint(r) {
+					input = append(input, r)
+				}
+			}
+
+			showLast = masked && MaskMode == MASK_MODE_SHOW_LAST
+		}
+
+		renderRawInput(input, masked, showLast)
+
+		if !showLast {
+			continue
+		}
+
+		err = waitInput(MaskShowDelay)
+
+		switch err {
+		case nil:
+			continue
+		case ErrTimeout:
+			renderRawInput(input, masked, false)
+		default:
+			return "", err
+		}
+	}
+}
+
+// renderRawInput print prompt and input (masked if required)
+func renderRawInput(input []rune, masked bool, showLast bool) {
+	if masked {
+		renderPrivate(input, showLast)
+	} else {
+		fmt.Printf("\r%s%s\033[0K", Prompt, string(input))
+	}
+}
+
+// renderPrivate print prompt and masked input
+func renderPrivate(input []rune, showLast bool) {
+	var masked string
+
+	switch {
+	case MaskMode == MASK_MODE_HIDDEN:
+		masked = ""
+	case showLast && len(input) != 0:
+		masked = renderMask(string(input[:len(input)-1])) + string(input[len(input)-1])
+	default:
+		masked = renderMask(string(input))
+	}
+
+	if MaskSymbolColorTag == "" || masked == "" {
+		fmt.Printf("\r%s%s\033[0K", Prompt, masked)
+	} else {
+		fmt.Printf(
+			"\r%s%s%s%s\033[0K", Prompt,
+			fmtc.Sprint(MaskSymbolColorTag), masked, fmtc.Sprint("{!}"),
+		)
+	}
+}
+
+// renderMask return masked version of input
+func renderMask(input string) string {
+	if MaskRenderer != nil {
+		return MaskRenderer(input)
+	}
+
+	return strings.Repeat(MaskSymbol, utf8.RuneCountInString(input))
+}
@@ -0,0 +1,152 @@
+// +build !windows
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// PagerStatusColorTag is fmtc color tag used for internal pager status line
+var PagerStatusColorTag = "{s}"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type pager struct {
+	lines  []string
+	offset int
+	height int
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Page print content using pager if stdout is a terminal and content doesn't
+// fit into terminal window. Pager from PAGER environment variable is used if
+// it set, otherwise content will be shown by internal pager.
+func Page(content string) error {
+	content = strings.TrimRight(content, "\n")
+	lines := strings.Split(content, "\n")
+
+	_, height := GetSize()
+
+	if NonInteractive || !isTerminal(os.Stdout.Fd()) || height <= 0 || len(lines) < height {
+		fmt.Println(content)
+		return nil
+	}
+
+	if os.Getenv("PAGER") != "" {
+		return runExternalPager(os.Getenv("PAGER"), content)
+	}
+
+	p := &pager{lines: lines, height: height - 1}
+
+	return p.run()
+}
+
+// PageReader read all data from reader and print it using pager
+func PageReader(r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return err
+	}
+
+	return Page(string(data))
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// runExternalPager run given pager command and send content to its stdin
+func runExternalPager(command, content string) error {
+	cmd := exec.Command("/bin/sh", "-c", command)
+
+	cmd.Stdin = strings.NewReader(content + "\n")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+// run show content and process navigation keys until user exit from pager
+func (p *pager) run() error {
+	// Switch to alternate screen buffer and back after exit
+	fmt.Print("\033[?1049h")
+	defer fmt.Print("\033[?1049l")
+
+	maxOffset := len(p.lines) - p.height
+
+	for {
+		p.render()
+
+		key, r, err := ReadKey()
+
+		if err != nil {
+			if err == ErrKillSignal {
+				return nil
+			}
+
+			return err
+		}
+
+		switch {
+		case key == KEY_ESC, key == KEY_RUNE && r == 'q':
+			return nil
+		case key == KEY_DOWN, key == KEY_ENTER, key == KEY_RUNE && r == 'j':
+			p.offset++
+		case key == KEY_UP, key == KEY_RUNE && r == 'k':
+			p.offset--
+		case key == KEY_PGDN, key == KEY_RUNE && (r == ' ' || r == 'f'):
+			p.offset += p.height
+		case key == KEY_PGUP, key == KEY_RUNE && r == 'b':
+			p.offset -= p.height
+		case key == KEY_HOME, key == KEY_RUNE && r == 'g':
+			p.offset = 0
+		case key == KEY_END, key == KEY_RUNE && r == 'G':
+			p.offset = maxOffset
+		}
+
+		if p.offset > maxOffset {
+			p.offset = maxOffset
+		}
+
+		if p.offset < 0 {
+			p.offset = 0
+		}
+	}
+}
+
+// render print visible part of content and status line
+func (p *pager) render() {
+	fmt.Print("\033[H\033[2J")
+
+	for i := p.offset; i < len(p.lines) && i < p.offset+p.height; i++ {
+		fmt.Println(p.lines[i])
+	}
+
+	last := p.offset + p.height
+
+	if last >= len(p.lines) {
+		fmtc.Printf(PagerStatusColorTag + "(END){!}")
+		return
+	}
+
+	fmtc.Printf(
+		PagerStatusColorTag+"Lines %d-%d of %d (q - quit){!}",
+		p.offset+1, last, len(p.lines),
+	)
+}
@@ -0,0 +1,35 @@
+// +build darwin
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"syscall"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+const (
+	_IOCTL_GETATTR = syscall.TIOCGETA
+	_IOCTL_SETATTR = syscall.TIOCSETA
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// selectStdin wait until stdin will be ready for reading
+func selectStdin(timeout *syscall.Timeval) (bool, error) {
+	set := &syscall.FdSet{}
+
+	// Stdin always has descriptor 0, so we can set first bit directly
+	set.Bits[0] = 1
+
+	err := syscall.Select(1, set, nil, nil, timeout)
+
+	return set.Bits[0]&1 != 0, err
+}
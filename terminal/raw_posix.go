@@ -0,0 +1,252 @@
+// +build !windows
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"time"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// _CANCEL_CHECK_INTERVAL is interval between input cancellation checks
+const _CANCEL_CHECK_INTERVAL = 100 * time.Millisecond
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// inputLimit contains limits for user input
+type inputLimit struct {
+	deadline time.Time       // Input deadline (zero if not set)
+	cancel   <-chan struct{} // Channel closed when input is canceled (nil if not set)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// errInputCanceled is returned if input was canceled through limit cancel channel
+var errInputCanceled = errors.New("Input canceled")
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ReadKey read single key press from stdin. For printable symbols KEY_RUNE
+// and pressed symbol will be returned.
+func ReadKey() (Key, rune, error) {
+	data, err := readRaw()
+
+	if err != nil {
+		return KEY_UNKNOWN, 0, err
+	}
+
+	key, r := parseKey(data)
+
+	if key == KEY_CTRL_C {
+		return key, 0, ErrKillSignal
+	}
+
+	return key, r, nil
+}
+
+// ReadRune read single symbol from stdin without waiting for Enter key
+func ReadRune() (rune, error) {
+	data, err := readRaw()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if data[0] == 0x03 {
+		return 0, ErrKillSignal
+	}
+
+	r, _ := utf8.DecodeRune(data)
+
+	return r, nil
+}
+
+// IsTTY return true if both stdin and stdout are connected to terminal
+func IsTTY() bool {
+	return isTerminal(os.Stdin.Fd()) && isTerminal(os.Stdout.Fd())
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// waitInput wait until some data will be available in stdin, return ErrTimeout
+// if there is no input after timeout
+func waitInput(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		remaining := deadline.Sub(time.Now())
+
+		// Negative timeout is not allowed by select
+		if remaining <= 0 {
+			return ErrTimeout
+		}
+
+		tv := syscall.NsecToTimeval(remaining.Nanoseconds())
+		ready, err := selectStdin(&tv)
+
+		switch {
+		case err == syscall.EINTR:
+			continue
+		case err != nil:
+			return err
+		case !ready:
+			return ErrTimeout
+		}
+
+		return nil
+	}
+}
+
+// newTimeoutLimit return input limit for given timeout (nil if timeout is 0)
+func newTimeoutLimit(timeout time.Duration) *inputLimit {
+	if timeout <= 0 {
+		return nil
+	}
+
+	return &inputLimit{deadline: time.Now().Add(timeout)}
+}
+
+// wait wait until some data will be available in stdin, return ErrTimeout
+// after deadline and errInputCanceled if input was canceled
+func (l *inputLimit) wait() error {
+	for {
+		if l.cancel != nil {
+			select {
+			case <-l.cancel:
+				return errInputCanceled
+			default:
+			}
+		}
+
+		timeout := _CANCEL_CHECK_INTERVAL
+
+		if !l.deadline.IsZero() {
+			remaining := l.deadline.Sub(time.Now())
+
+			if remaining <= 0 {
+				return ErrTimeout
+			}
+
+			if l.cancel == nil || remaining < timeout {
+				timeout = remaining
+			}
+		}
+
+		err := waitInput(timeout)
+
+		if err != ErrTimeout {
+			return err
+		}
+	}
+}
+
+// readRaw switch terminal to raw mode and read data from stdin
+func readRaw() ([]byte, error) {
+	if NonInteractive {
+		return nil, ErrNonInteractive
+	}
+
+	fd := os.Stdin.Fd()
+	state, err := enableRawMode(fd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer restoreMode(fd, state)
+
+	// Terminal sends escape sequence for special keys at once, so we can
+	// read whole sequence in one read call
+	buf := make([]byte, 16)
+	n, err := syscall.Read(int(fd), buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return nil, ErrKillSignal
+	}
+
+	return buf[:n], nil
+}
+
+// enableRawMode switch terminal to raw mode and return previous state
+func enableRawMode(fd uintptr) (*syscall.Termios, error) {
+	state, err := getTermios(fd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	raw := *state
+
+	raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP |
+		syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+
+	err = setTermios(fd, &raw)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// restoreMode restore previous terminal state
+func restoreMode(fd uintptr, state *syscall.Termios) error {
+	return setTermios(fd, state)
+}
+
+// getTermios return current terminal settings
+func getTermios(fd uintptr) (*syscall.Termios, error) {
+	state := &syscall.Termios{}
+
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL, fd, _IOCTL_GETATTR,
+		uintptr(unsafe.Pointer(state)),
+	)
+
+	if errno != 0 {
+		return nil, errno
+	}
+
+	return state, nil
+}
+
+// setTermios apply terminal settings
+func setTermios(fd uintptr, state *syscall.Termios) error {
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL, fd, _IOCTL_SETATTR,
+		uintptr(unsafe.Pointer(state)),
+	)
+
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+// isTerminal return true if given descriptor is connected to terminal
+func isTerminal(fd uintptr) bool {
+	_, err := getTermios(fd)
+	return err == nil
+}
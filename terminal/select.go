@@ -0,0 +1,286 @@
+// +build !windows
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SelectCursor is symbol used for marking current option in select menu
+var SelectCursor = ">"
+
+// SelectColorTag is fmtc color tag used for current option in select menu
+var SelectColorTag = "{c*}"
+
+// SelectMaxItems is maximum number of options displayed at once
+var SelectMaxItems = 10
+
+// SelectCheckedMark is mark used for selected options in multi-select menu
+var SelectCheckedMark = "[x]"
+
+// SelectUncheckedMark is mark used for not selected options in multi-select menu
+var SelectUncheckedMark = "[ ]"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type selectMenu struct {
+	title    string
+	options  []string
+	query    string
+	filtered []int
+	cursor   int
+	offset   int
+	lines    int
+	multi    bool
+	selected map[int]bool
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ReadSelect show menu with given options and return index of option selected
+// by user. Arrow keys are used for navigation, any printable symbols are used
+// for filtering options.
+func ReadSelect(title string, options []string) (int, error) {
+	if len(options) == 0 {
+		return -1, errors.New("Options list can't be empty")
+	}
+
+	menu := &selectMenu{title: title, options: options}
+	menu.filter()
+
+	for {
+		menu.render()
+
+		key, r, err := ReadKey()
+
+		if err != nil {
+			menu.clean()
+			return -1, err
+		}
+
+		switch key {
+		case KEY_ENTER:
+			if len(menu.filtered) == 0 {
+				continue
+			}
+
+			index := menu.filtered[menu.cursor]
+			menu.finish(options[index])
+
+			return index, nil
+
+		case KEY_ESC:
+			if menu.query == "" {
+				menu.clean()
+				return -1, ErrKillSignal
+			}
+
+			menu.query = ""
+			menu.filter()
+
+		default:
+			menu.handleKey(key, r)
+		}
+	}
+}
+
+// ReadMultiSelect show menu with given options and return indexes of options
+// selected by user. Space key is used for selecting/unselecting options, Enter
+// key is used for confirmation.
+func ReadMultiSelect(title string, options []string, preselected []int) ([]int, error) {
+	if len(options) == 0 {
+		return nil, errors.New("Options list can't be empty")
+	}
+
+	menu := &selectMenu{
+		title:    title,
+		options:  options,
+		multi:    true,
+		selected: make(map[int]bool),
+	}
+
+	for _, index := range preselected {
+		if index >= 0 && index < len(options) {
+			menu.selected[index] = true
+		}
+	}
+
+	menu.filter()
+
+	for {
+		menu.render()
+
+		key, r, err := ReadKey()
+
+		if err != nil {
+			menu.clean()
+			return nil, err
+		}
+
+		switch {
+		case key == KEY_ENTER:
+			var (
+				indexes []int
+				values  []string
+			)
+
+			for index := range options {
+				if menu.selected[index] {
+					indexes = append(indexes, index)
+					values = append(values, options[index])
+				}
+			}
+
+			menu.finish(strings.Join(values, ", "))
+
+			return indexes, nil
+
+		case key == KEY_RUNE && r == ' ':
+			if len(menu.filtered) != 0 {
+				index := menu.filtered[menu.cursor]
+				menu.selected[index] = !menu.selected[index]
+			}
+
+		case key == KEY_ESC:
+			if menu.query == "" {
+				menu.clean()
+				return nil, ErrKillSignal
+			}
+
+			menu.query = ""
+			menu.filter()
+
+		default:
+			menu.handleKey(key, r)
+		}
+	}
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// handleKey process navigation and filtering keys
+func (m *selectMenu) handleKey(key Key, r rune) {
+	switch key {
+	case KEY_UP, KEY_CTRL_P:
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case KEY_DOWN, KEY_CTRL_N:
+		if m.cursor < len(m.filtered)-1 {
+			m.cursor++
+		}
+	case KEY_PGUP, KEY_HOME:
+		m.cursor = 0
+	case KEY_PGDN, KEY_END:
+		m.cursor = len(m.filtered) - 1
+	case KEY_BACKSPACE:
+		if m.query != "" {
+			queryRunes := []rune(m.query)
+			m.query = string(queryRunes[:len(queryRunes)-1])
+			m.filter()
+		}
+	case KEY_RUNE:
+		m.query += string(r)
+		m.filter()
+	}
+
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+
+	switch {
+	case m.cursor < m.offset:
+		m.offset = m.cursor
+	case m.cursor >= m.offset+SelectMaxItems:
+		m.offset = m.cursor - SelectMaxItems + 1
+	}
+}
+
+// filter update list of options which match current query
+func (m *selectMenu) filter() {
+	m.filtered = nil
+	m.cursor, m.offset = 0, 0
+
+	query := strings.ToLower(m.query)
+
+	for index, option := range m.options {
+		if query == "" || strings.Contains(strings.ToLower(fmtc.Clean(option)), query) {
+			m.filtered = append(m.filtered, index)
+		}
+	}
+}
+
+// render print menu
+func (m *selectMenu) render() {
+	m.clean()
+
+	if m.title != "" {
+		fmtc.Printf(CurrentTheme.Prompt+"%s{!}\n", m.title)
+		m.lines++
+	}
+
+	fmtc.Printf("%s%s\n", Prompt, m.query)
+	m.lines++
+
+	if len(m.filtered) == 0 {
+		fmtc.Println("{s}No matches found{!}")
+		m.lines++
+		return
+	}
+
+	for i := m.offset; i < len(m.filtered) && i < m.offset+SelectMaxItems; i++ {
+		option := m.options[m.filtered[i]]
+
+		if m.multi {
+			if m.selected[m.filtered[i]] {
+				option = SelectCheckedMark + " " + option
+			} else {
+				option = SelectUncheckedMark + " " + option
+			}
+		}
+
+		if i == m.cursor {
+			fmtc.Printf(SelectColorTag+"%s %s{!}\n", SelectCursor, option)
+		} else {
+			fmtc.Printf("%s %s\n", strings.Repeat(" ", utf8.RuneCountInString(SelectCursor)), option)
+		}
+
+		m.lines++
+	}
+}
+
+// clean remove previously rendered menu
+func (m *selectMenu) clean() {
+	if m.lines == 0 {
+		return
+	}
+
+	fmt.Printf("\033[%dA\r\033[0J", m.lines)
+
+	m.lines = 0
+}
+
+// finish remove menu and print selected value
+func (m *selectMenu) finish(value string) {
+	m.clean()
+
+	if m.title != "" {
+		fmtc.Printf(CurrentTheme.Prompt+"%s{!}\n", m.title)
+	}
+
+	fmtc.Printf("%s%s\n", Prompt, value)
+}
@@ -0,0 +1,106 @@
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"sync"
+	"time"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SpinnerFrames contains spinner animation frames
+var SpinnerFrames = []string{"|", "/", "-", "\\"}
+
+// SpinnerDelay is delay between spinner animation frames
+var SpinnerDelay = 100 * time.Millisecond
+
+// SpinnerColorTag is fmtc color tag used for spinner output
+var SpinnerColorTag = "{y}"
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type spinner struct {
+	title string
+	stop  chan bool
+	done  chan bool
+	mu    *sync.Mutex
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var activeSpinner = &spinner{mu: &sync.Mutex{}}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// StartAction print message about action currently in progress with
+// animated spinner
+func StartAction(title string) {
+	activeSpinner.mu.Lock()
+	defer activeSpinner.mu.Unlock()
+
+	if activeSpinner.stop != nil {
+		activeSpinner.halt()
+	}
+
+	activeSpinner.title = title
+	activeSpinner.stop = make(chan bool)
+	activeSpinner.done = make(chan bool)
+
+	go activeSpinner.animate()
+}
+
+// StopAction stop spinner animation and print action execution
+// status (0 - OK, 1 - ERROR)
+func StopAction(status int) {
+	activeSpinner.mu.Lock()
+	defer activeSpinner.mu.Unlock()
+
+	if activeSpinner.stop == nil {
+		return
+	}
+
+	activeSpinner.halt()
+
+	PrintActionMessage(activeSpinner.title)
+	PrintActionStatus(status)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// animate render spinner frames until stop signal is received
+func (s *spinner) animate() {
+	var frame int
+
+	for {
+		fmtc.Printf(
+			"\r{*}%s:{!} "+SpinnerColorTag+"%s{!} ",
+			s.title, SpinnerFrames[frame%len(SpinnerFrames)],
+		)
+
+		select {
+		case <-s.stop:
+			fmtc.Printf("\r\033[0K")
+			close(s.done)
+			return
+		case <-time.After(SpinnerDelay):
+			frame++
+		}
+	}
+}
+
+// halt stop animation and wait until spinner line will be cleaned
+func (s *spinner) halt() {
+	close(s.stop)
+	<-s.done
+
+	s.stop = nil
+	s.done = nil
+}
@@ -0,0 +1,103 @@
+// +build !windows
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+	"pkg.re/essentialkaos/ek.v7/fmtutil"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+type statusLine struct {
+	height int
+	mu     *sync.Mutex
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var activeStatus = &statusLine{mu: &sync.Mutex{}}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// SetStatus reserve bottom line of terminal window and print given message in it.
+// Output printed after calling this method will be scrolled above status line.
+// Message can contain fmtc color tags.
+func SetStatus(message string, args ...interface{}) {
+	activeStatus.mu.Lock()
+	defer activeStatus.mu.Unlock()
+
+	if !isTerminal(os.Stdout.Fd()) {
+		return
+	}
+
+	if activeStatus.height == 0 {
+		_, height := GetSize()
+
+		if height < 2 {
+			return
+		}
+
+		activeStatus.height = height
+
+		// Add empty line for status line, then limit scroll region
+		// and return cursor to previous position
+		fmt.Printf("\n\0337\033[1;%dr\0338\033[1A", height-1)
+	}
+
+	if len(args) != 0 {
+		message = fmt.Sprintf(message, args...)
+	}
+
+	width, _ := GetSize()
+
+	if width > 0 && Width(message) > width {
+		message = truncateString(Strip(message), width)
+	}
+
+	fmt.Printf("\0337\033[%d;1H\033[2K", activeStatus.height)
+	fmt.Print(fmtc.Sprint(message))
+	fmt.Print("\0338")
+}
+
+// ClearStatus remove status line and restore scroll region
+func ClearStatus() {
+	activeStatus.mu.Lock()
+	defer activeStatus.mu.Unlock()
+
+	if activeStatus.height == 0 {
+		return
+	}
+
+	fmt.Printf("\0337\033[r\033[%d;1H\033[2K\0338", activeStatus.height)
+
+	activeStatus.height = 0
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// truncateString cut string to given number of terminal cells
+func truncateString(s string, width int) string {
+	var size int
+
+	for index, r := range s {
+		size += fmtutil.RuneWidth(r)
+
+		if size > width {
+			return s[:index]
+		}
+	}
+
+	return s
+}
@@ -11,14 +11,19 @@ package terminal
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"pkg.re/essentialkaos/go-linenoise.v3"
 
 	"pkg.re/essentialkaos/ek.v7/fmtc"
+	"pkg.re/essentialkaos/ek.v7/passwd"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -26,6 +31,25 @@ import (
 // ErrKillSignal is error type when user cancel input
 var ErrKillSignal = linenoise.ErrKillSignal
 
+// ErrTimeout is error type when user doesn't enter anything before timeout
+var ErrTimeout = errors.New("Input timeout")
+
+// ErrNonInteractive is error returned by input methods in non-interactive mode
+var ErrNonInteractive = errors.New("Can't read user input in non-interactive mode")
+
+// ErrEmptyInput is error returned by NotEmpty validator
+var ErrEmptyInput = errors.New("You must enter non empty value")
+
+// ErrInvalidNumber is error returned by IsNumber validator
+var ErrInvalidNumber = errors.New("Entered value is not a valid number")
+
+// ErrInvalidFloat is error returned by IsFloat validator
+var ErrInvalidFloat = errors.New("Entered value is not a valid floating point number")
+
+// ErrPasswordMismatch is error returned by ReadPasswordConfirm if entered
+// passwords are not equal
+var ErrPasswordMismatch = errors.New("Passwords don't match")
+
 // Prompt is prompt string
 var Prompt = "> "
 
@@ -35,63 +59,229 @@ var MaskSymbol = "*"
 // MaskSymbolColorTag is fmtc color tag used for MaskSymbol output
 var MaskSymbolColorTag = ""
 
+// NonInteractive is non-interactive mode flag. In this mode input methods
+// don't wait for user input and return ErrNonInteractive (ReadAnswer returns
+// default answer if it set). Flag is set by default if stdin is not a terminal.
+var NonInteractive = !isTerminal(os.Stdin.Fd())
+
+// DefaultTheme is default terminal theme
+var DefaultTheme = Theme{
+	Error:   "{r}",
+	Warn:    "{y}",
+	Info:    "{c}",
+	Success: "{g}",
+	Prompt:  "{c}",
+}
+
+// CurrentTheme is theme used for terminal output
+var CurrentTheme = DefaultTheme
+
+// ConfirmationAttempts is maximum number of attempts for ReadConfirmation
+var ConfirmationAttempts = 3
+
+// MLTerminator is line used for finishing multi-line input
+var MLTerminator = "."
+
+// ConfirmPasswordTitle is title used for password confirmation prompt
+var ConfirmPasswordTitle = "Please confirm password"
+
+// Theme contains fmtc color tags used for messages and prompts
+type Theme struct {
+	Error   string // Tag for error messages
+	Warn    string // Tag for warning messages
+	Info    string // Tag for info messages
+	Success string // Tag for success messages
+	Prompt  string // Tag for input titles
+}
+
+// Validator is input validation function. Validator can return modified input
+// (e.g. without spaces) or error with description of problem.
+type Validator func(input string) (string, error)
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// ReadUI read user input
-func ReadUI(title string, nonEmpty bool) (string, error) {
+// NotEmpty is validator which checks that input is not empty
+var NotEmpty Validator = func(input string) (string, error) {
+	if strings.TrimSpace(input) == "" {
+		return input, ErrEmptyInput
+	}
+
+	return input, nil
+}
+
+// IsNumber is validator which checks that input is integer number
+var IsNumber Validator = func(input string) (string, error) {
+	input = strings.TrimSpace(input)
+
+	if input == "" {
+		return input, nil
+	}
+
+	_, err := strconv.Atoi(input)
+
+	if err != nil {
+		return input, ErrInvalidNumber
+	}
+
+	return input, nil
+}
+
+// IsFloat is validator which checks that input is floating point number
+var IsFloat Validator = func(input string) (string, error) {
+	input = strings.TrimSpace(input)
+
+	if input == "" {
+		return input, nil
+	}
+
+	_, err := strconv.ParseFloat(input, 64)
+
+	if err != nil {
+		return input, ErrInvalidFloat
+	}
+
+	return input, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ReadUI read user input. If some validators are given, user will be asked
+// for input until all validators return no error.
+func ReadUI(title string, nonEmpty bool, validators ...Validator) (string, error) {
 	return readUserInput(
-		title, nonEmpty, false,
+		title, nonEmpty, false, nil, validators,
 	)
 }
 
-// ReadAnswer read user answer for Y/n question
-func ReadAnswer(title, defaultAnswer string) (bool, error) {
+// ReadUITimeout read user input. If user doesn't finish input before timeout,
+// ErrTimeout will be returned.
+func ReadUITimeout(title string, nonEmpty bool, timeout time.Duration, validators ...Validator) (string, error) {
+	return readUserInput(
+		title, nonEmpty, false, newTimeoutLimit(timeout), validators,
+	)
+}
+
+// ReadML read multi-line user input. Input will be finished when user enter
+// line equal to MLTerminator.
+func ReadML(title string) (string, error) {
+	if NonInteractive {
+		return "", ErrNonInteractive
+	}
+
+	if title != "" {
+		fmtc.Printf(CurrentTheme.Prompt+"%s{!}\n", title)
+	}
+
+	var lines []string
+
 	for {
-		answer, err := readUserInput(
-			getAnswerTitle(title, defaultAnswer), false, false,
-		)
+		line, err := linenoise.Line(Prompt)
 
 		if err != nil {
-			return false, err
+			return "", err
 		}
 
-		if answer == "" {
-			answer = defaultAnswer
+		if line == MLTerminator {
+			break
 		}
 
-		switch strings.ToUpper(answer) {
-		case "Y":
-			return true, nil
-		case "N":
-			return false, nil
-		default:
-			PrintWarnMessage("\nPlease enter Y or N\n")
-		}
+		lines = append(lines, line)
 	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// ReadAnswer read user answer for Y/n question
+func ReadAnswer(title, defaultAnswer string) (bool, error) {
+	return readAnswer(title, defaultAnswer, nil)
+}
+
+// ReadAnswerTimeout read user answer for Y/n question. If user doesn't finish
+// input before timeout, ErrTimeout will be returned.
+func ReadAnswerTimeout(title, defaultAnswer string, timeout time.Duration) (bool, error) {
+	return readAnswer(title, defaultAnswer, newTimeoutLimit(timeout))
 }
 
 // ReadPassword read password or some private input which will be hidden
-// after pressing Enter
-func ReadPassword(title string, nonEmpty bool) (string, error) {
-	return readUserInput(title, nonEmpty, true)
+// after pressing Enter. If some validators are given, user will be asked
+// for input until all validators return no error.
+func ReadPassword(title string, nonEmpty bool, validators ...Validator) (string, error) {
+	return readUserInput(title, nonEmpty, true, nil, validators)
+}
+
+// ReadPasswordConfirm read password and ask user to enter it again for
+// confirmation. If showStrength is true, password strength will be printed
+// after first input. If passwords are not equal ErrPasswordMismatch will
+// be returned.
+func ReadPasswordConfirm(title string, showStrength bool) (string, error) {
+	password, err := readUserInput(title, true, true, nil, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	if showStrength {
+		printPasswordStrength(password)
+	}
+
+	confirmation, err := readUserInput(ConfirmPasswordTitle, true, true, nil, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	if password != confirmation {
+		return "", ErrPasswordMismatch
+	}
+
+	return password, nil
+}
+
+// ReadConfirmation ask user to type expected phrase (e.g. name of resource
+// which will be removed) and return true if entered phrase is equal to expected.
+// User has ConfirmationAttempts attempts, after each wrong attempt difference
+// between entered and expected phrases will be shown.
+func ReadConfirmation(title, expected string) (bool, error) {
+	for i := 0; i < ConfirmationAttempts; i++ {
+		if i != 0 {
+			title = ""
+		}
+
+		input, err := readUserInput(title, false, false, nil, nil)
+
+		if err != nil {
+			return false, err
+		}
+
+		if input == expected {
+			return true, nil
+		}
+
+		PrintWarnMessage("\nEntered value doesn't match expected value")
+		printMismatch(input, expected)
+	}
+
+	return false, nil
 }
 
 // PrintErrorMessage print error message
 func PrintErrorMessage(message string, args ...interface{}) {
-	if len(args) == 0 {
-		fmtc.Fprintf(os.Stderr, "{r}%s{!}\n", message)
-	} else {
-		fmtc.Fprintf(os.Stderr, "{r}%s{!}\n", fmt.Sprintf(message, args...))
-	}
+	printMessage(os.Stderr, CurrentTheme.Error, message, args)
 }
 
 // PrintWarnMessage print warning message
 func PrintWarnMessage(message string, args ...interface{}) {
-	if len(args) == 0 {
-		fmtc.Fprintf(os.Stderr, "{y}%s{!}\n", message)
-	} else {
-		fmtc.Fprintf(os.Stderr, "{y}%s{!}\n", fmt.Sprintf(message, args...))
-	}
+	printMessage(os.Stderr, CurrentTheme.Warn, message, args)
+}
+
+// PrintInfoMessage print info message
+func PrintInfoMessage(message string, args ...interface{}) {
+	printMessage(os.Stdout, CurrentTheme.Info, message, args)
+}
+
+// PrintSuccessMessage print success message
+func PrintSuccessMessage(message string, args ...interface{}) {
+	printMessage(os.Stdout, CurrentTheme.Success, message, args)
 }
 
 // PrintActionMessage print message about action currently in progress
@@ -103,9 +293,9 @@ func PrintActionMessage(message string) {
 func PrintActionStatus(status int) {
 	switch status {
 	case 0:
-		fmtc.Println("{g}OK{!}")
+		fmtc.Println(CurrentTheme.Success + "OK{!}")
 	case 1:
-		fmtc.Println("{r}ERROR{!}")
+		fmtc.Println(CurrentTheme.Error + "ERROR{!}")
 	}
 }
 
@@ -128,7 +318,7 @@ func SetHintHandler(h func(input string) string) {
 
 func getPrivateHider(message string) string {
 	prefix := strings.Repeat(" ", utf8.RuneCountInString(Prompt))
-	masking := strings.Repeat(MaskSymbol, utf8.RuneCountInString(message))
+	masking := renderMask(message)
 
 	return fmt.Sprintf("%s\033[1A%s", prefix, masking)
 }
@@ -148,9 +338,99 @@ func getAnswerTitle(title, defaultAnswer string) string {
 	}
 }
 
-func readUserInput(title string, nonEmpty bool, private bool) (string, error) {
+func printMessage(w io.Writer, tag, message string, args []interface{}) {
+	if len(args) != 0 {
+		message = fmt.Sprintf(message, args...)
+	}
+
+	fmtc.Fprintf(w, tag+"%s{!}\n", message)
+}
+
+func printMismatch(input, expected string) {
+	fmt.Printf("Expected: %s\n", expected)
+	fmt.Printf("Entered:  %s\n\n", renderMismatch(input, expected))
+}
+
+// renderMismatch return input with highlighted matched and mismatched symbols
+func renderMismatch(input, expected string) string {
+	inputRunes := []rune(input)
+	expectedRunes := []rune(expected)
+
+	result := ""
+
+	for index, r := range inputRunes {
+		if index < len(expectedRunes) && expectedRunes[index] == r {
+			result += fmtc.Sprint(CurrentTheme.Success) + string(r) + fmtc.Sprint("{!}")
+		} else {
+			result += fmtc.Sprint(CurrentTheme.Error) + string(r) + fmtc.Sprint("{!}")
+		}
+	}
+
+	if len(inputRunes) < len(expectedRunes) {
+		result += fmtc.Sprint("{s}") + strings.Repeat("_", len(expectedRunes)-len(inputRunes)) + fmtc.Sprint("{!}")
+	}
+
+	return result
+}
+
+func printPasswordStrength(password string) {
+	switch passwd.GetStrength(password) {
+	case passwd.STRENGTH_STRONG:
+		fmtc.Println("{s}Password strength:{!} " + CurrentTheme.Success + "strong{!}")
+	case passwd.STRENGTH_MEDIUM:
+		fmtc.Println("{s}Password strength:{!} " + CurrentTheme.Warn + "medium{!}")
+	default:
+		fmtc.Println("{s}Password strength:{!} " + CurrentTheme.Error + "weak{!}")
+	}
+}
+
+func readAnswer(title, defaultAnswer string, limit *inputLimit) (bool, error) {
+	if NonInteractive {
+		switch strings.ToUpper(defaultAnswer) {
+		case "Y":
+			return true, nil
+		case "N":
+			return false, nil
+		default:
+			return false, ErrNonInteractive
+		}
+	}
+
+	for {
+		answer, err := readUserInput(
+			getAnswerTitle(title, defaultAnswer), false, false, limit, nil,
+		)
+
+		if err != nil {
+			return false, err
+		}
+
+		if answer == "" {
+			answer = defaultAnswer
+		}
+
+		switch strings.ToUpper(answer) {
+		case "Y":
+			return true, nil
+		case "N":
+			return false, nil
+		default:
+			PrintWarnMessage("\nPlease enter Y or N\n")
+		}
+	}
+}
+
+func readUserInput(title string, nonEmpty bool, private bool, limit *inputLimit, validators []Validator) (string, error) {
+	if NonInteractive {
+		return "", ErrNonInteractive
+	}
+
 	if title != "" {
-		fmtc.Printf("{c}%s{!}\n", title)
+		fmtc.Printf(CurrentTheme.Prompt+"%s{!}\n", title)
+	}
+
+	if nonEmpty {
+		validators = append([]Validator{NotEmpty}, validators...)
 	}
 
 	var (
@@ -158,19 +438,24 @@ func readUserInput(title string, nonEmpty bool, private bool) (string, error) {
 		err   error
 	)
 
+INPUTLOOP:
 	for {
-		input, err = linenoise.Line(Prompt)
+		switch {
+		case private && MaskMode != MASK_MODE_DEFAULT:
+			input, err = readRawLine(true, limit)
+		case limit != nil:
+			// linenoise can't be interrupted, so limited input
+			// is read in raw mode
+			input, err = readRawLine(false, limit)
+		default:
+			input, err = linenoise.Line(Prompt)
+		}
 
 		if err != nil {
 			return "", err
 		}
 
-		if nonEmpty && strings.TrimSpace(input) == "" {
-			PrintWarnMessage("\nYou must enter non empty value\n")
-			continue
-		}
-
-		if private && input != "" {
+		if private && MaskMode == MASK_MODE_DEFAULT && input != "" {
 			if MaskSymbolColorTag == "" {
 				fmt.Println(getPrivateHider(input))
 			} else {
@@ -178,6 +463,15 @@ func readUserInput(title string, nonEmpty bool, private bool) (string, error) {
 			}
 		}
 
+		for _, validator := range validators {
+			input, err = validator(input)
+
+			if err != nil {
+				PrintWarnMessage("\n%s\n", err.Error())
+				continue INPUTLOOP
+			}
+		}
+
 		break
 	}
 
@@ -0,0 +1,63 @@
+// +build !windows,go1.7
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"context"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ReadUIContext read user input. If context deadline is exceeded before user
+// finish input, ErrTimeout will be returned. If context is canceled, context
+// error will be returned.
+func ReadUIContext(ctx context.Context, title string, nonEmpty bool, validators ...Validator) (string, error) {
+	input, err := readUserInput(
+		title, nonEmpty, false, newContextLimit(ctx), validators,
+	)
+
+	return input, getContextError(ctx, err)
+}
+
+// ReadAnswerContext read user answer for Y/n question. If context deadline is
+// exceeded before user finish input, ErrTimeout will be returned. If context
+// is canceled, context error will be returned.
+func ReadAnswerContext(ctx context.Context, title, defaultAnswer string) (bool, error) {
+	ok, err := readAnswer(title, defaultAnswer, newContextLimit(ctx))
+
+	return ok, getContextError(ctx, err)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// newContextLimit return input limit for given context (nil if context
+// can't be canceled)
+func newContextLimit(ctx context.Context) *inputLimit {
+	if ctx.Done() == nil {
+		return nil
+	}
+
+	deadline, _ := ctx.Deadline()
+
+	return &inputLimit{deadline: deadline, cancel: ctx.Done()}
+}
+
+// getContextError convert input cancellation error to context error
+func getContextError(ctx context.Context, err error) error {
+	if err != errInputCanceled {
+		return err
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrTimeout
+	}
+
+	return ctx.Err()
+}
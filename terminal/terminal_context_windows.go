@@ -0,0 +1,24 @@
+// +build go1.7
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"context"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func ReadUIContext(ctx context.Context, title string, nonEmpty bool, validators ...Validator) (string, error) {
+	return "", nil
+}
+
+func ReadAnswerContext(ctx context.Context, title, defaultAnswer string) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,213 @@
+// +build !windows
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"strings"
+	"testing"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+
+	. "pkg.re/check.v1"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func Test(t *testing.T) { TestingT(t) }
+
+type TerminalSuite struct{}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var _ = Suite(&TerminalSuite{})
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func (s *TerminalSuite) TestWrapText(c *C) {
+	cases := []struct {
+		text   string
+		indent int
+		width  int
+		result string
+	}{
+		{"", 0, 10, ""},
+		{"short", 0, 10, "short"},
+		{"one two three", 0, 7, "one two\nthree"},
+		{"one   two \t three", 0, 80, "one two three"},
+		{"one two three", 2, 9, "  one two\n  three"},
+		{"one two", 4, 6, "    one\n    two"},
+		{"verylongword two", 0, 5, "verylongword\ntwo"},
+		{"first\n\nsecond", 1, 10, " first\n\n second"},
+		{"{g}green{!} {r}red{!} text", 0, 9, "{g}green{!} {r}red{!}\ntext"},
+		{"\x1b[1mbold\x1b[0m text", 0, 9, "\x1b[1mbold\x1b[0m text"},
+		{"日本語 日本語", 0, 8, "日本語\n日本語"},
+		{"тест тест", 0, 9, "тест тест"},
+	}
+
+	for _, tc := range cases {
+		c.Assert(wrapText(tc.text, tc.indent, tc.width), Equals, tc.result, Commentf("text: %q", tc.text))
+	}
+}
+
+func (s *TerminalSuite) TestParseKey(c *C) {
+	cases := []struct {
+		data string
+		key  Key
+		r    rune
+	}{
+		{"", KEY_UNKNOWN, 0},
+		{"\x1b", KEY_ESC, 0},
+		{"\x1b[A", KEY_UP, 0},
+		{"\x1bOB", KEY_DOWN, 0},
+		{"\x1b[C", KEY_RIGHT, 0},
+		{"\x1b[D", KEY_LEFT, 0},
+		{"\x1b[H", KEY_HOME, 0},
+		{"\x1b[1~", KEY_HOME, 0},
+		{"\x1b[F", KEY_END, 0},
+		{"\x1b[4~", KEY_END, 0},
+		{"\x1b[3~", KEY_DELETE, 0},
+		{"\x1b[5~", KEY_PGUP, 0},
+		{"\x1bOP", KEY_F1, 0},
+		{"\x1b[[E", KEY_F5, 0},
+		{"\x1b[15~", KEY_F5, 0},
+		{"\x1b[24~", KEY_F12, 0},
+		{"\x1b[99~", KEY_UNKNOWN, 0},
+		{"\r", KEY_ENTER, 0},
+		{"\n", KEY_ENTER, 0},
+		{"\t", KEY_TAB, 0},
+		{"\x7f", KEY_BACKSPACE, 0},
+		{"\x01", KEY_CTRL_A, 0},
+		{"\x1a", KEY_CTRL_Z, 0},
+		{"\x1c", KEY_UNKNOWN, 0},
+		{"a", KEY_RUNE, 'a'},
+		{"Ж", KEY_RUNE, 'Ж'},
+		{"日", KEY_RUNE, '日'},
+		{"\xff", KEY_UNKNOWN, 0},
+	}
+
+	for _, tc := range cases {
+		key, r := parseKey([]byte(tc.data))
+
+		c.Assert(key, Equals, tc.key, Commentf("data: %q", tc.data))
+		c.Assert(r, Equals, tc.r, Commentf("data: %q", tc.data))
+	}
+}
+
+func (s *TerminalSuite) TestSelectFilter(c *C) {
+	m := &selectMenu{options: []string{"Apple", "{g}Banana{!}", "Cherry", "Pineapple", "Яблоко"}}
+
+	m.filter()
+
+	c.Assert(m.filtered, DeepEquals, []int{0, 1, 2, 3, 4})
+
+	m.cursor, m.offset = 3, 1
+	m.query = "APP"
+	m.filter()
+
+	c.Assert(m.filtered, DeepEquals, []int{0, 3})
+	c.Assert(m.cursor, Equals, 0)
+	c.Assert(m.offset, Equals, 0)
+
+	m.query = "g}"
+	m.filter()
+
+	c.Assert(m.filtered, HasLen, 0)
+
+	m.query = "ябл"
+	m.filter()
+
+	c.Assert(m.filtered, DeepEquals, []int{4})
+}
+
+func (s *TerminalSuite) TestSelectKeys(c *C) {
+	maxItems := SelectMaxItems
+	SelectMaxItems = 3
+	defer func() { SelectMaxItems = maxItems }()
+
+	m := &selectMenu{options: []string{"one", "two", "three", "four", "five"}}
+	m.filter()
+
+	m.handleKey(KEY_UP, 0)
+	c.Assert(m.cursor, Equals, 0)
+
+	m.handleKey(KEY_DOWN, 0)
+	m.handleKey(KEY_CTRL_N, 0)
+	c.Assert(m.cursor, Equals, 2)
+	c.Assert(m.offset, Equals, 0)
+
+	m.handleKey(KEY_DOWN, 0)
+	c.Assert(m.cursor, Equals, 3)
+	c.Assert(m.offset, Equals, 1)
+
+	m.handleKey(KEY_END, 0)
+	m.handleKey(KEY_DOWN, 0)
+	c.Assert(m.cursor, Equals, 4)
+	c.Assert(m.offset, Equals, 2)
+
+	m.handleKey(KEY_CTRL_P, 0)
+	m.handleKey(KEY_CTRL_P, 0)
+	m.handleKey(KEY_UP, 0)
+	c.Assert(m.cursor, Equals, 1)
+	c.Assert(m.offset, Equals, 1)
+
+	m.handleKey(KEY_HOME, 0)
+	c.Assert(m.cursor, Equals, 0)
+	c.Assert(m.offset, Equals, 0)
+
+	m.handleKey(KEY_RUNE, 't')
+	c.Assert(m.query, Equals, "t")
+	c.Assert(m.filtered, DeepEquals, []int{1, 2})
+
+	m.handleKey(KEY_RUNE, 'w')
+	c.Assert(m.filtered, DeepEquals, []int{1})
+
+	m.handleKey(KEY_RUNE, 'x')
+	c.Assert(m.filtered, HasLen, 0)
+
+	m.handleKey(KEY_PGDN, 0)
+	c.Assert(m.cursor, Equals, 0)
+
+	m.handleKey(KEY_BACKSPACE, 0)
+	m.handleKey(KEY_BACKSPACE, 0)
+	c.Assert(m.query, Equals, "t")
+	c.Assert(m.filtered, DeepEquals, []int{1, 2})
+
+	m.handleKey(KEY_BACKSPACE, 0)
+	m.handleKey(KEY_BACKSPACE, 0)
+	c.Assert(m.query, Equals, "")
+	c.Assert(m.filtered, HasLen, 5)
+
+	m.handleKey(KEY_RUNE, 'ж')
+	m.handleKey(KEY_BACKSPACE, 0)
+	c.Assert(m.query, Equals, "")
+}
+
+func (s *TerminalSuite) TestMismatch(c *C) {
+	ok := func(s string) string {
+		return fmtc.Sprint(CurrentTheme.Success) + s + fmtc.Sprint("{!}")
+	}
+
+	bad := func(s string) string {
+		return fmtc.Sprint(CurrentTheme.Error) + s + fmtc.Sprint("{!}")
+	}
+
+	missing := func(n int) string {
+		return fmtc.Sprint("{s}") + strings.Repeat("_", n) + fmtc.Sprint("{!}")
+	}
+
+	c.Assert(renderMismatch("", ""), Equals, "")
+	c.Assert(renderMismatch("abc", "abc"), Equals, ok("a")+ok("b")+ok("c"))
+	c.Assert(renderMismatch("abd", "abc"), Equals, ok("a")+ok("b")+bad("d"))
+	c.Assert(renderMismatch("ab", "abcd"), Equals, ok("a")+ok("b")+missing(2))
+	c.Assert(renderMismatch("abcd", "ab"), Equals, ok("a")+ok("b")+bad("c")+bad("d"))
+	c.Assert(renderMismatch("{r}", "{g}"), Equals, ok("{")+bad("r")+ok("}"))
+	c.Assert(renderMismatch("тест", "тост"), Equals, ok("т")+bad("е")+ok("с")+ok("т"))
+	c.Assert(renderMismatch("日本", "日本語"), Equals, ok("日")+ok("本")+missing(1))
+}
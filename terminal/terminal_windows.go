@@ -11,6 +11,18 @@ package terminal
 
 import (
 	"errors"
+	"io"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Private input masking modes
+const (
+	MASK_MODE_DEFAULT = iota
+	MASK_MODE_SYMBOLS
+	MASK_MODE_SHOW_LAST
+	MASK_MODE_HIDDEN
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -18,6 +30,25 @@ import (
 // ErrKillSignal is error type when user cancel input
 var ErrKillSignal = errors.New("")
 
+// ErrTimeout is error type when user doesn't enter anything before timeout
+var ErrTimeout = errors.New("")
+
+// ErrPasswordMismatch is error returned by ReadPasswordConfirm if entered
+// passwords are not equal
+var ErrPasswordMismatch = errors.New("")
+
+// ErrNonInteractive is error returned by input methods in non-interactive mode
+var ErrNonInteractive = errors.New("")
+
+// ErrEmptyInput is error returned by NotEmpty validator
+var ErrEmptyInput = errors.New("")
+
+// ErrInvalidNumber is error returned by IsNumber validator
+var ErrInvalidNumber = errors.New("")
+
+// ErrInvalidFloat is error returned by IsFloat validator
+var ErrInvalidFloat = errors.New("")
+
 // Prompt is prompt string
 var Prompt = "> "
 
@@ -27,17 +58,127 @@ var MaskSymbol = "*"
 // MaskSymbolColorTag is fmtc color tag used for MaskSymbol output
 var MaskSymbolColorTag = ""
 
+// MaskMode is masking mode used for private input
+var MaskMode = MASK_MODE_DEFAULT
+
+// MaskShowDelay is delay before last typed symbol will be masked in
+// MASK_MODE_SHOW_LAST mode
+var MaskShowDelay = 500 * time.Millisecond
+
+// MaskRenderer is custom function for rendering masked input
+var MaskRenderer func(input string) string
+
+// NonInteractive is non-interactive mode flag
+var NonInteractive = false
+
+// DefaultTheme is default terminal theme
+var DefaultTheme = Theme{
+	Error:   "{r}",
+	Warn:    "{y}",
+	Info:    "{c}",
+	Success: "{g}",
+	Prompt:  "{c}",
+}
+
+// CurrentTheme is theme used for terminal output
+var CurrentTheme = DefaultTheme
+
+// ConfirmationAttempts is maximum number of attempts for ReadConfirmation
+var ConfirmationAttempts = 3
+
+// MLTerminator is line used for finishing multi-line input
+var MLTerminator = "."
+
+// DefaultEditor is editor used by ReadEditor if EDITOR env variable is empty
+var DefaultEditor = "vi"
+
+// DefaultWrapWidth is line width used by PrintWrapped if terminal window
+// size can't be determined
+var DefaultWrapWidth = 80
+
+// ConfirmPasswordTitle is title used for password confirmation prompt
+var ConfirmPasswordTitle = "Please confirm password"
+
+// PagerStatusColorTag is fmtc color tag used for internal pager status line
+var PagerStatusColorTag = "{s}"
+
+// SelectCursor is symbol used for marking current option in select menu
+var SelectCursor = ">"
+
+// SelectColorTag is fmtc color tag used for current option in select menu
+var SelectColorTag = "{c*}"
+
+// SelectMaxItems is maximum number of options displayed at once
+var SelectMaxItems = 10
+
+// SelectCheckedMark is mark used for selected options in multi-select menu
+var SelectCheckedMark = "[x]"
+
+// SelectUncheckedMark is mark used for not selected options in multi-select menu
+var SelectUncheckedMark = "[ ]"
+
+// Theme contains fmtc color tags used for messages and prompts
+type Theme struct {
+	Error   string // Tag for error messages
+	Warn    string // Tag for warning messages
+	Info    string // Tag for info messages
+	Success string // Tag for success messages
+	Prompt  string // Tag for input titles
+}
+
+// Validator is input validation function
+type Validator func(input string) (string, error)
+
+// NotEmpty is validator which checks that input is not empty
+var NotEmpty Validator = func(input string) (string, error) {
+	return input, nil
+}
+
+// IsNumber is validator which checks that input is integer number
+var IsNumber Validator = func(input string) (string, error) {
+	return input, nil
+}
+
+// IsFloat is validator which checks that input is floating point number
+var IsFloat Validator = func(input string) (string, error) {
+	return input, nil
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-func ReadUI(title string, nonEmpty bool) (string, error) {
+func ReadUI(title string, nonEmpty bool, validators ...Validator) (string, error) {
 	return "", nil
 }
 
+func ReadUITimeout(title string, nonEmpty bool, timeout time.Duration, validators ...Validator) (string, error) {
+	return "", nil
+}
+
+func ReadML(title string) (string, error) {
+	return "", nil
+}
+
+func ReadEditor(initial string) (string, error) {
+	return "", nil
+}
+
+func ReadConfirmation(title, expected string) (bool, error) {
+	return false, nil
+}
+
 func ReadAnswer(title, defaultAnswer string) (bool, error) {
 	return true, nil
 }
 
-func ReadPassword(title string, nonEmpty bool) (string, error) {
+func ReadAnswerTimeout(title, defaultAnswer string, timeout time.Duration) (bool, error) {
+	return true, nil
+}
+
+func ReadPassword(title string, nonEmpty bool, validators ...Validator) (string, error) {
+	return "", nil
+}
+
+func ReadPasswordConfirm(title string, showStrength bool) (string, error) {
 	return "", nil
 }
 
@@ -49,6 +190,14 @@ func PrintWarnMessage(message string, args ...interface{}) {
 	return
 }
 
+func PrintInfoMessage(message string, args ...interface{}) {
+	return
+}
+
+func PrintSuccessMessage(message string, args ...interface{}) {
+	return
+}
+
 func PrintActionMessage(message string) {
 	return
 }
@@ -68,3 +217,43 @@ func SetCompletionHandler(h func(in string) []string) {
 func SetHintHandler(h func(input string) string) {
 	return
 }
+
+func IsTTY() bool {
+	return false
+}
+
+func PrintWrapped(text string, indent int) {
+	return
+}
+
+func SetStatus(message string, args ...interface{}) {
+	return
+}
+
+func ClearStatus() {
+	return
+}
+
+func ReadKey() (Key, rune, error) {
+	return KEY_UNKNOWN, 0, nil
+}
+
+func ReadRune() (rune, error) {
+	return 0, nil
+}
+
+func ReadSelect(title string, options []string) (int, error) {
+	return -1, nil
+}
+
+func ReadMultiSelect(title string, options []string, preselected []int) ([]int, error) {
+	return nil, nil
+}
+
+func Page(content string) error {
+	return nil
+}
+
+func PageReader(r io.Reader) error {
+	return nil
+}
@@ -0,0 +1,26 @@
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"pkg.re/essentialkaos/ek.v7/fmtutil"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Strip remove fmtc color tags and ANSI escape sequences from given string
+func Strip(s string) string {
+	return fmtutil.Strip(s)
+}
+
+// Width return number of terminal cells required for printing given string.
+// Color tags and ANSI escape sequences are ignored, wide symbols take two
+// cells, combining and control symbols doesn't take any space.
+func Width(s string) int {
+	return fmtutil.Width(s)
+}
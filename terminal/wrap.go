@@ -0,0 +1,77 @@
+// +build !windows
+
+package terminal
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"strings"
+
+	"pkg.re/essentialkaos/ek.v7/fmtc"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// DefaultWrapWidth is line width used by PrintWrapped if terminal window
+// size can't be determined
+var DefaultWrapWidth = 80
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// PrintWrapped print text wrapped to current terminal window width. Every line
+// will be prefixed by given number of spaces. Text can contain fmtc color tags.
+func PrintWrapped(text string, indent int) {
+	width, _ := GetSize()
+
+	if width <= 0 {
+		width = DefaultWrapWidth
+	}
+
+	fmtc.Println(wrapText(text, indent, width))
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// wrapText wrap text to given width, color tags and escape sequences are
+// not counted in line length
+func wrapText(text string, indent, width int) string {
+	var result []string
+
+	prefix := strings.Repeat(" ", indent)
+
+	for _, paragraph := range strings.Split(text, "\n") {
+		words := strings.Fields(paragraph)
+
+		if len(words) == 0 {
+			result = append(result, "")
+			continue
+		}
+
+		line, lineWidth := "", 0
+
+		for _, word := range words {
+			wordWidth := Width(word)
+
+			if lineWidth != 0 && indent+lineWidth+1+wordWidth > width {
+				result = append(result, prefix+line)
+				line, lineWidth = "", 0
+			}
+
+			if lineWidth == 0 {
+				line, lineWidth = word, wordWidth
+			} else {
+				line += " " + word
+				lineWidth += 1 + wordWidth
+			}
+		}
+
+		result = append(result, prefix+line)
+	}
+
+	return strings.Join(result, "\n")
+}
@@ -0,0 +1,137 @@
+package timeutil
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// FirstWeekday is first day of week used by StartOfWeek and EndOfWeek
+var FirstWeekday = time.Monday
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// StartOfDay return start of day. This and other methods below work with time
+// in its own location, so result is correct even if day contains DST transition.
+func StartOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// StartOfWeek return start of week (see FirstWeekday)
+func StartOfWeek(t time.Time) time.Time {
+	shift := (7 + int(t.Weekday()) - int(FirstWeekday)) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-shift, 0, 0, 0, 0, t.Location())
+}
+
+// StartOfMonth return start of month
+func StartOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// StartOfYear return start of year
+func StartOfYear(t time.Time) time.Time {
+	return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfDay return end of day (last nanosecond)
+func EndOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 999999999, t.Location())
+}
+
+// EndOfWeek return end of week (see FirstWeekday)
+func EndOfWeek(t time.Time) time.Time {
+	start := StartOfWeek(t)
+	return EndOfDay(time.Date(start.Year(), start.Month(), start.Day()+6, 0, 0, 0, 0, t.Location()))
+}
+
+// EndOfMonth return end of month
+func EndOfMonth(t time.Time) time.Time {
+	return EndOfDay(time.Date(t.Year(), t.Month(), DaysInMonth(t), 0, 0, 0, 0, t.Location()))
+}
+
+// EndOfYear return end of year
+func EndOfYear(t time.Time) time.Time {
+	return EndOfDay(time.Date(t.Year(), time.December, 31, 0, 0, 0, 0, t.Location()))
+}
+
+// PrevDay return the same time of previous day
+func PrevDay(t time.Time) time.Time {
+	return t.AddDate(0, 0, -1)
+}
+
+// NextDay return the same time of next day
+func NextDay(t time.Time) time.Time {
+	return t.AddDate(0, 0, 1)
+}
+
+// PrevMonth return the same day of previous month (if previous month doesn't
+// contain this day, last day of month will be used)
+func PrevMonth(t time.Time) time.Time {
+	return addMonths(t, -1)
+}
+
+// NextMonth return the same day of next month (if next month doesn't contain
+// this day, last day of month will be used)
+func NextMonth(t time.Time) time.Time {
+	return addMonths(t, 1)
+}
+
+// DaysInMonth return number of days in month
+func DaysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// IsWeekend return true if given day is Saturday or Sunday
+func IsWeekend(t time.Time) bool {
+	switch t.Weekday() {
+	case time.Saturday, time.Sunday:
+		return true
+	}
+
+	return false
+}
+
+// AddWorkdays add given number of working days (Monday-Friday) to given
+// time (number can be negative)
+func AddWorkdays(t time.Time, days int) time.Time {
+	step := 1
+
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+
+		if !IsWeekend(t) {
+			days--
+		}
+	}
+
+	return t
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// addMonths add months to date with saving day
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	day := t.Day()
+
+	if day > DaysInMonth(first) {
+		day = DaysInMonth(first)
+	}
+
+	return time.Date(
+		first.Year(), first.Month(), day,
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(),
+		t.Location(),
+	)
+}
@@ -26,6 +26,15 @@ func ExamplePrettyDuration() {
 	// 1 day 10 hours 17 minutes and 36 seconds
 }
 
+func ExampleShortDuration() {
+	fmt.Println(ShortDuration(7985))
+	fmt.Println(ShortDuration(123456 * time.Second))
+
+	// Output:
+	// 2h 13m 5s
+	// 1d 10h 17m 36s
+}
+
 func ExampleParseDuration() {
 	fmt.Println(ParseDuration("2w3d10h20m35s"))
 	fmt.Println(PrettyDuration(ParseDuration("2w3d10h20m35s")))
@@ -35,6 +44,14 @@ func ExampleParseDuration() {
 	// 2 weeks 3 days 10 hours 20 minutes and 35 seconds
 }
 
+func ExampleDurationAs() {
+	d := time.Duration(ParseDuration("1w3d12h")) * time.Second
+
+	fmt.Println(DurationAs(d, DAY))
+
+	// Output: 10.5
+}
+
 func ExampleFormat() {
 	date := time.Date(2010, 6, 15, 15, 30, 45, 1234, time.Local)
 
@@ -44,6 +61,56 @@ func ExampleFormat() {
 	// Tuesday 15/Jun/2010 15:30:45.000001234
 }
 
+func ExampleParse() {
+	d, err := Parse("2017/03/08 14:05:09", "%Y/%m/%d %H:%M:%S")
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Println(d)
+
+	// Output: 2017-03-08 14:05:09 +0000 UTC
+}
+
+func ExamplePrettyRelative() {
+	fmt.Println(PrettyRelative(time.Now().Add(-3 * time.Minute)))
+	fmt.Println(PrettyRelative(time.Now().Add(50 * time.Hour)))
+
+	// Output:
+	// 3 minutes ago
+	// in 2 days
+}
+
+func ExampleStartOfMonth() {
+	d := time.Date(2017, 3, 8, 14, 5, 9, 0, time.UTC)
+
+	fmt.Println(StartOfMonth(d))
+	fmt.Println(EndOfMonth(d))
+
+	// Output:
+	// 2017-03-01 00:00:00 +0000 UTC
+	// 2017-03-31 23:59:59.999999999 +0000 UTC
+}
+
+func ExampleAddWorkdays() {
+	// Friday
+	d := time.Date(2017, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	fmt.Println(AddWorkdays(d, 1).Weekday())
+
+	// Output: Monday
+}
+
+func ExampleNextMonth() {
+	d := time.Date(2017, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	fmt.Println(NextMonth(d))
+
+	// Output: 2017-02-28 12:00:00 +0000 UTC
+}
+
 func ExampleDurationToSeconds() {
 	fmt.Println(DurationToSeconds(time.Minute))
 
@@ -0,0 +1,339 @@
+package timeutil
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// dateParser contains parser state
+type dateParser struct {
+	value string
+
+	year, month, day  int
+	hour, min, sec    int
+	nsec, yday        int
+	isPM, hasAMPM     bool
+	unix              int64
+	hasUnix, hasMonth bool
+	loc               *time.Location
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Parse parse date using format with the same sequences as Format. Sequences
+// %c, %C, %g, %G, %u, %U, %V, %w, %W, %x and %X are not supported.
+func Parse(value, f string) (time.Time, error) {
+	p := &dateParser{value: value, month: 1, day: 1}
+
+	err := p.parse(f)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Can't parse \"%s\": %v", value, err)
+	}
+
+	if p.value != "" {
+		return time.Time{}, fmt.Errorf("Can't parse \"%s\": unexpected text \"%s\"", value, p.value)
+	}
+
+	return p.getTime()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parse parse value using given format
+func (p *dateParser) parse(f string) error {
+	for i := 0; i < len(f); i++ {
+		if f[i] != '%' || i+1 == len(f) {
+			if p.value == "" || p.value[0] != f[i] {
+				return fmt.Errorf("text doesn't match format \"%s\"", f)
+			}
+
+			p.value = p.value[1:]
+
+			continue
+		}
+
+		i++
+
+		tag := f[i]
+
+		if tag == ':' && i+1 < len(f) && f[i+1] == 'z' {
+			i++
+		}
+
+		err := p.parseTag(tag)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseTag parse value of sequence with given tag
+func (p *dateParser) parseTag(tag byte) error {
+	var err error
+
+	switch tag {
+	case '%', 'n', 't':
+		return p.parse(map[byte]string{'%': "%", 'n': "\n", 't': "\t"}[tag])
+	case 'D':
+		return p.parse("%m/%d/%y")
+	case 'F':
+		return p.parse("%Y-%m-%d")
+	case 'r':
+		return p.parse("%I:%M:%S %p")
+	case 'R':
+		return p.parse("%H:%M")
+	case 'T':
+		return p.parse("%H:%M:%S")
+	case 'a':
+		_, err = p.readName(func(i int) string { return getShortWeekday(time.Weekday(i)) }, 0, 6)
+	case 'A':
+		_, err = p.readName(func(i int) string { return getLongWeekday(time.Weekday(i)) }, 0, 6)
+	case 'b', 'h':
+		p.month, err = p.readName(func(i int) string { return getShortMonth(time.Month(i)) }, 1, 12)
+		p.hasMonth = true
+	case 'B':
+		p.month, err = p.readName(func(i int) string { return getLongMonth(time.Month(i)) }, 1, 12)
+		p.hasMonth = true
+	case 'd', 'e':
+		p.day, err = p.readNum(2, 1, 31)
+	case 'H', 'k':
+		p.hour, err = p.readNum(2, 0, 23)
+	case 'I', 'l':
+		p.hour, err = p.readNum(2, 1, 12)
+	case 'j':
+		p.yday, err = p.readNum(3, 1, 366)
+	case 'K':
+		p.nsec, err = p.readNum(3, 0, 999)
+		p.nsec *= int(time.Millisecond)
+	case 'm':
+		p.month, err = p.readNum(2, 1, 12)
+		p.hasMonth = true
+	case 'M':
+		p.min, err = p.readNum(2, 0, 59)
+	case 'N':
+		p.nsec, err = p.readNum(9, 0, 999999999)
+	case 'p', 'P':
+		err = p.readAMPM()
+	case 's':
+		var unix int
+		unix, err = p.readNum(19, 0, int(^uint(0)>>1))
+		p.unix, p.hasUnix = int64(unix), true
+	case 'S':
+		p.sec, err = p.readNum(2, 0, 60)
+	case 'y':
+		p.year, err = p.readNum(2, 0, 99)
+
+		if p.year < 69 {
+			p.year += 2000
+		} else {
+			p.year += 1900
+		}
+	case 'Y':
+		p.year, err = p.readNum(4, 0, 9999)
+	case 'z', ':':
+		err = p.readTimezone(tag == ':')
+	case 'Z':
+		err = p.readZoneName()
+	default:
+		return fmt.Errorf("sequence %%%c is not supported", tag)
+	}
+
+	if err != nil {
+		return fmt.Errorf("wrong value for %%%c: %v", tag, err)
+	}
+
+	return nil
+}
+
+// readNum read number with given max number of digits
+func (p *dateParser) readNum(digits, min, max int) (int, error) {
+	// Numbers can be padded by space (%e, %k, %l)
+	if digits == 2 && strings.HasPrefix(p.value, " ") {
+		p.value, digits = p.value[1:], 1
+	}
+
+	var result, size int
+
+	for size < digits && size < len(p.value) && p.value[size] >= '0' && p.value[size] <= '9' {
+		result = result*10 + int(p.value[size]-'0')
+		size++
+	}
+
+	if size == 0 {
+		return 0, fmt.Errorf("number expected")
+	}
+
+	if result < min || result > max {
+		return 0, fmt.Errorf("value %d is out of range", result)
+	}
+
+	p.value = p.value[size:]
+
+	return result, nil
+}
+
+// readName read name returned by given function for values in range
+func (p *dateParser) readName(nameFunc func(int) string, min, max int) (int, error) {
+	for i := min; i <= max; i++ {
+		name := nameFunc(i)
+
+		if len(p.value) >= len(name) && strings.EqualFold(p.value[:len(name)], name) {
+			p.value = p.value[len(name):]
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown name")
+}
+
+// readAMPM read AM/PM marker
+func (p *dateParser) readAMPM() error {
+	if len(p.value) < 2 {
+		return fmt.Errorf("AM or PM expected")
+	}
+
+	switch strings.ToUpper(p.value[:2]) {
+	case "AM":
+		p.isPM = false
+	case "PM":
+		p.isPM = true
+	default:
+		return fmt.Errorf("AM or PM expected")
+	}
+
+	p.value, p.hasAMPM = p.value[2:], true
+
+	return nil
+}
+
+// readTimezone read numeric timezone
+func (p *dateParser) readTimezone(separator bool) error {
+	if strings.HasPrefix(p.value, "Z") {
+		p.value, p.loc = p.value[1:], time.UTC
+		return nil
+	}
+
+	if p.value == "" || (p.value[0] != '+' && p.value[0] != '-') {
+		return fmt.Errorf("timezone offset expected")
+	}
+
+	sign := p.value[0]
+	p.value = p.value[1:]
+
+	hours, err := p.readNum(2, 0, 23)
+
+	if err != nil {
+		return err
+	}
+
+	if separator {
+		if !strings.HasPrefix(p.value, ":") {
+			return fmt.Errorf("separator expected")
+		}
+
+		p.value = p.value[1:]
+	}
+
+	minutes, err := p.readNum(2, 0, 59)
+
+	if err != nil {
+		return err
+	}
+
+	offset := hours*_HOUR + minutes*_MINUTE
+
+	if sign == '-' {
+		offset *= -1
+	}
+
+	p.loc = time.FixedZone("", offset)
+
+	return nil
+}
+
+// readZoneName read alphabetic time zone abbreviation
+func (p *dateParser) readZoneName() error {
+	var size int
+
+	for size < len(p.value) && p.value[size] >= 'A' && p.value[size] <= 'Z' {
+		size++
+	}
+
+	if size < 3 {
+		return fmt.Errorf("time zone abbreviation expected")
+	}
+
+	name := p.value[:size]
+	p.value = p.value[size:]
+
+	switch name {
+	case "UTC", "GMT":
+		p.loc = time.UTC
+	default:
+		// Abbreviation can't be converted to offset, so we use local
+		// timezone if abbreviation matches it
+		if localName, _ := time.Now().Zone(); localName == name {
+			p.loc = time.Local
+		} else {
+			p.loc = time.FixedZone(name, 0)
+		}
+	}
+
+	return nil
+}
+
+// getTime return parsed time
+func (p *dateParser) getTime() (time.Time, error) {
+	loc := p.loc
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	if p.hasUnix {
+		return time.Unix(p.unix, int64(p.nsec)).In(loc), nil
+	}
+
+	hour := p.hour
+
+	if p.hasAMPM {
+		switch {
+		case p.isPM && hour < 12:
+			hour += 12
+		case !p.isPM && hour == 12:
+			hour = 0
+		}
+	}
+
+	if p.yday != 0 && !p.hasMonth {
+		t := time.Date(p.year, 1, 1, hour, p.min, p.sec, p.nsec, loc).AddDate(0, 0, p.yday-1)
+
+		if t.Year() != p.year {
+			return time.Time{}, fmt.Errorf("Day %d is out of range for year %d", p.yday, p.year)
+		}
+
+		return t, nil
+	}
+
+	t := time.Date(p.year, time.Month(p.month), p.day, hour, p.min, p.sec, p.nsec, loc)
+
+	if t.Day() != p.day {
+		return time.Time{}, fmt.Errorf("Day %d is out of range for month %d", p.day, p.month)
+	}
+
+	return t, nil
+}
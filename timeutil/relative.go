@@ -0,0 +1,108 @@
+package timeutil
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"time"
+
+	"pkg.re/essentialkaos/ek.v7/pluralize"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// RelativeLocale contains messages used for relative time rendering
+type RelativeLocale struct {
+	Pluralizer pluralize.Pluralizer // Pluralization function for units
+
+	Now    string // Message for current moment (e.g. "just now")
+	Past   string // Format for past time (e.g. "%s ago")
+	Future string // Format for future time (e.g. "in %s")
+
+	Seconds []string // Plural forms of units
+	Minutes []string
+	Hours   []string
+	Days    []string
+	Weeks   []string
+	Months  []string
+	Years   []string
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// RelativeEn is english locale for relative time rendering
+var RelativeEn = &RelativeLocale{
+	Pluralizer: pluralize.En,
+
+	Now:    "just now",
+	Past:   "%s ago",
+	Future: "in %s",
+
+	Seconds: []string{"second", "seconds"},
+	Minutes: []string{"minute", "minutes"},
+	Hours:   []string{"hour", "hours"},
+	Days:    []string{"day", "days"},
+	Weeks:   []string{"week", "weeks"},
+	Months:  []string{"month", "months"},
+	Years:   []string{"year", "years"},
+}
+
+// DefaultRelativeLocale is locale used by PrettyRelative
+var DefaultRelativeLocale = RelativeEn
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// PrettyRelative return relative time (e.g. 3 minutes ago or in 2 days)
+func PrettyRelative(t time.Time) string {
+	return PrettyRelativeSpecial(DefaultRelativeLocale, t, time.Now())
+}
+
+// PrettyRelativeSpecial return relative to given moment time rendered using
+// given locale
+func PrettyRelativeSpecial(locale *RelativeLocale, t, now time.Time) string {
+	if locale == nil {
+		locale = RelativeEn
+	}
+
+	d := now.Sub(t)
+	format := locale.Past
+
+	if d < 0 {
+		d, format = -d, locale.Future
+	}
+
+	// Round to seconds, so small delay between getting time and
+	// rendering doesn't affect result
+	seconds := int((d + time.Second/2) / time.Second)
+
+	if seconds == 0 {
+		return locale.Now
+	}
+
+	var value int
+	var forms []string
+
+	switch {
+	case seconds >= 365*_DAY:
+		value, forms = seconds/(365*_DAY), locale.Years
+	case seconds >= 30*_DAY:
+		value, forms = seconds/(30*_DAY), locale.Months
+	case seconds >= _WEEK:
+		value, forms = seconds/_WEEK, locale.Weeks
+	case seconds >= _DAY:
+		value, forms = seconds/_DAY, locale.Days
+	case seconds >= _HOUR:
+		value, forms = seconds/_HOUR, locale.Hours
+	case seconds >= _MINUTE:
+		value, forms = seconds/_MINUTE, locale.Minutes
+	default:
+		value, forms = seconds, locale.Seconds
+	}
+
+	return fmt.Sprintf(format, pluralize.PluralizeSpecial(locale.Pluralizer, value, forms...))
+}
@@ -27,29 +27,30 @@ const (
 	_WEEK   = 604800
 )
 
+// Durations which are not provided by time package
+const (
+	DAY  = 24 * time.Hour
+	WEEK = 7 * DAY
+
+	// AVERAGE_MONTH is average gregorian month duration (30.436875 days)
+	AVERAGE_MONTH = 2629746 * time.Second
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// MonthDuration is month duration used by ParseDuration (can be set to
+// AVERAGE_MONTH if months must be converted to seconds more accurately)
+var MonthDuration = 30 * DAY
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // PrettyDuration return pretty duration (e.g. 1 hour 45 seconds)
 func PrettyDuration(d interface{}) string {
-	var (
-		result   []string
-		duration int
-	)
+	var result []string
 
-	switch d.(type) {
-	case time.Duration:
-		duration = int(d.(time.Duration).Seconds())
-	case int8:
-		duration = int(d.(int8))
-	case int16:
-		duration = int(d.(int16))
-	case int32:
-		duration = int(d.(int32))
-	case int64:
-		duration = int(d.(int64))
-	case int:
-		duration = d.(int)
-	default:
+	duration, ok := convertDuration(d)
+
+	if !ok {
 		return "Wrong duration value"
 	}
 
@@ -87,6 +88,46 @@ func PrettyDuration(d interface{}) string {
 	return result[0]
 }
 
+// ShortDuration return short pretty duration (e.g. 2h 13m 5s)
+func ShortDuration(d interface{}) string {
+	var result []string
+
+	if td, ok := d.(time.Duration); ok && td > 0 && td < time.Second {
+		return fmt.Sprintf("%dms", td/time.Millisecond)
+	}
+
+	duration, ok := convertDuration(d)
+
+	if !ok {
+		return "Wrong duration value"
+	}
+
+	if duration <= 0 {
+		return "0s"
+	}
+
+	if duration >= _DAY {
+		result = append(result, fmt.Sprintf("%dd", duration/_DAY))
+		duration = duration % _DAY
+	}
+
+	if duration >= _HOUR {
+		result = append(result, fmt.Sprintf("%dh", duration/_HOUR))
+		duration = duration % _HOUR
+	}
+
+	if duration >= _MINUTE {
+		result = append(result, fmt.Sprintf("%dm", duration/_MINUTE))
+		duration = duration % _MINUTE
+	}
+
+	if duration > 0 {
+		result = append(result, fmt.Sprintf("%ds", duration))
+	}
+
+	return strings.Join(result, " ")
+}
+
 // Format return formated date to string with linux date formating
 //
 // Interpreted sequences:
@@ -161,7 +202,18 @@ func DurationToSeconds(d time.Duration) int64 {
 	return int64(d / 1000000000)
 }
 
-// ParseDuration parses duration in 1w2d3h5m6s format and return as seconds
+// DurationAs return duration in given units (e.g. DurationAs(d, WEEK))
+func DurationAs(d, unit time.Duration) float64 {
+	if unit <= 0 {
+		return 0
+	}
+
+	return float64(d) / float64(unit)
+}
+
+// ParseDuration parses duration in 1mo1w2d3h5m6s format (spaces between parts
+// are allowed, so output of ShortDuration also can be parsed) and return
+// as seconds. Month duration is defined by MonthDuration.
 func ParseDuration(dur string) int64 {
 	if dur == "" {
 		return 0
@@ -173,8 +225,10 @@ func ParseDuration(dur string) int64 {
 		valueInt int64
 	)
 
-	for _, sym := range strings.ToLower(dur) {
-		switch sym {
+	input := []rune(strings.ToLower(dur))
+
+	for i := 0; i < len(input); i++ {
+		switch sym := input[i]; sym {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			value += string(sym)
 
@@ -195,9 +249,15 @@ func ParseDuration(dur string) int64 {
 
 		case 'm':
 			valueInt, _ = strconv.ParseInt(value, 10, 64)
-			result += valueInt * _MINUTE
 			value = ""
 
+			if i+1 < len(input) && input[i+1] == 'o' {
+				result += valueInt * int64(MonthDuration/time.Second)
+				i++
+			} else {
+				result += valueInt * _MINUTE
+			}
+
 		case 's':
 			valueInt, _ = strconv.ParseInt(value, 10, 64)
 			result += valueInt
@@ -215,6 +275,26 @@ func ParseDuration(dur string) int64 {
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// convertDuration convert duration value to number of seconds
+func convertDuration(d interface{}) (int, bool) {
+	switch d.(type) {
+	case time.Duration:
+		return int(d.(time.Duration).Seconds()), true
+	case int8:
+		return int(d.(int8)), true
+	case int16:
+		return int(d.(int16)), true
+	case int32:
+		return int(d.(int32)), true
+	case int64:
+		return int(d.(int64)), true
+	case int:
+		return d.(int), true
+	}
+
+	return 0, false
+}
+
 func replaceDateTag(d time.Time, input, output *bytes.Buffer) {
 	r, _, err := input.ReadRune()
 
@@ -289,6 +369,8 @@ func replaceDateTag(d time.Time, input, output *bytes.Buffer) {
 		output.WriteString(strconv.FormatInt(d.Unix(), 10))
 	case 'S':
 		fmt.Fprintf(output, "%02d", d.Second())
+	case 't':
+		output.WriteString("\t")
 	case 'T':
 		fmt.Fprintf(output, "%02d:%02d:%02d", d.Hour(), d.Minute(), d.Second())
 	case 'u':
@@ -12,6 +12,8 @@ import (
 	"testing"
 	"time"
 
+	"pkg.re/essentialkaos/ek.v7/pluralize"
+
 	. "pkg.re/check.v1"
 )
 
@@ -39,6 +41,21 @@ func (s *TimeUtilSuite) TestPretyDuration(c *C) {
 	c.Assert(PrettyDuration("string"), Equals, "Wrong duration value")
 }
 
+func (s *TimeUtilSuite) TestShortDuration(c *C) {
+	c.Assert(ShortDuration(time.Duration(0)), Equals, "0s")
+	c.Assert(ShortDuration(350*time.Millisecond), Equals, "350ms")
+	c.Assert(ShortDuration(45), Equals, "45s")
+	c.Assert(ShortDuration(int64(120)), Equals, "2m")
+	c.Assert(ShortDuration(7985), Equals, "2h 13m 5s")
+	c.Assert(ShortDuration(3605), Equals, "1h 5s")
+	c.Assert(ShortDuration(1370137*time.Second), Equals, "15d 20h 35m 37s")
+	c.Assert(ShortDuration(-10), Equals, "0s")
+	c.Assert(ShortDuration("string"), Equals, "Wrong duration value")
+
+	c.Assert(ParseDuration(ShortDuration(1370137)), Equals, int64(1370137))
+	c.Assert(ParseDuration("1d6h"), Equals, int64(108000))
+}
+
 func (s *TimeUtilSuite) TestDurationToSeconds(c *C) {
 	c.Assert(DurationToSeconds(time.Minute), Equals, int64(60))
 	c.Assert(DurationToSeconds(time.Hour), Equals, int64(3600))
@@ -181,4 +198,191 @@ func (s *TimeUtilSuite) TestDurationParsing(c *C) {
 	c.Assert(ParseDuration("1w3d12h30m30s"), Equals, int64(909030))
 	c.Assert(ParseDuration("10w"), Equals, int64(6048000))
 	c.Assert(ParseDuration("180"), Equals, int64(180))
+	c.Assert(ParseDuration("1mo"), Equals, int64(2592000))
+	c.Assert(ParseDuration("2mo 1m"), Equals, int64(5184060))
+
+	MonthDuration = AVERAGE_MONTH
+
+	c.Assert(ParseDuration("1mo1w"), Equals, int64(3234546))
+
+	MonthDuration = 30 * DAY
+
+	c.Assert(DurationAs(36*time.Hour, DAY), Equals, 1.5)
+	c.Assert(DurationAs(2*WEEK, DAY), Equals, 14.0)
+	c.Assert(DurationAs(90*time.Second, time.Minute), Equals, 1.5)
+	c.Assert(DurationAs(time.Hour, 0), Equals, 0.0)
+}
+
+func (s *TimeUtilSuite) TestParse(c *C) {
+	d := time.Date(2017, 3, 8, 14, 5, 9, 123456789, time.UTC)
+
+	for _, f := range []string{
+		"%Y/%m/%d %H:%M:%S.%N",
+		"%F %T.%N %z",
+		"%a %b %e %I:%M:%S %p %Y:%N %:z",
+		"%A, %d %B %y %r %N",
+		"%D %R:%S %N %Z",
+		"%j %Y %k:%M:%S %N %% %t%n",
+	} {
+		pd, err := Parse(Format(d, f), f)
+
+		c.Assert(err, IsNil, Commentf("Format: %s", f))
+		c.Assert(pd.Equal(d), Equals, true, Commentf("Format: %s → %v", f, pd))
+	}
+
+	pd, err := Parse("1488981909", "%s")
+
+	c.Assert(err, IsNil)
+	c.Assert(pd.Unix(), Equals, int64(1488981909))
+
+	pd, err = Parse("2017-03-08 10:15 +0300", "%Y-%m-%d %H:%M %z")
+
+	c.Assert(err, IsNil)
+	c.Assert(pd.UTC(), Equals, time.Date(2017, 3, 8, 7, 15, 0, 0, time.UTC))
+
+	pd, err = Parse("12:30 am", "%I:%M %P")
+
+	c.Assert(err, IsNil)
+	c.Assert(pd.Hour(), Equals, 0)
+
+	pd, err = Parse("2017-03-08T10:15:00Z", "%Y-%m-%dT%H:%M:%S%:z")
+
+	c.Assert(err, IsNil)
+	c.Assert(pd.Location(), Equals, time.UTC)
+
+	_, err = Parse("2017/31/01", "%Y/%m/%d")
+	c.Assert(err, ErrorMatches, `Can't parse "2017/31/01": wrong value for %m: value 31 is out of range`)
+
+	_, err = Parse("2017/02/30", "%Y/%m/%d")
+	c.Assert(err, ErrorMatches, "Day 30 is out of range for month 2")
+
+	_, err = Parse("2017 366", "%Y %j")
+	c.Assert(err, ErrorMatches, "Day 366 is out of range for year 2017")
+
+	_, err = Parse("2017/03/08 test", "%Y/%m/%d")
+	c.Assert(err, ErrorMatches, `Can't parse "2017/03/08 test": unexpected text " test"`)
+
+	_, err = Parse("2017-03-08", "%Y/%m/%d")
+	c.Assert(err, ErrorMatches, `Can't parse "2017-03-08": text doesn't match format "%Y/%m/%d"`)
+
+	_, err = Parse("10", "%V")
+	c.Assert(err, ErrorMatches, `Can't parse "10": sequence %V is not supported`)
+
+	_, err = Parse("Abc", "%b")
+	c.Assert(err, NotNil)
+	_, err = Parse("XM", "%p")
+	c.Assert(err, NotNil)
+	_, err = Parse("A", "%p")
+	c.Assert(err, NotNil)
+	_, err = Parse("0300", "%z")
+	c.Assert(err, NotNil)
+	_, err = Parse("+0x", "%z")
+	c.Assert(err, NotNil)
+	_, err = Parse("+03", "%:z")
+	c.Assert(err, NotNil)
+	_, err = Parse("+03:x", "%:z")
+	c.Assert(err, NotNil)
+	_, err = Parse("X", "%Z")
+	c.Assert(err, NotNil)
+	_, err = Parse("x", "%H")
+	c.Assert(err, NotNil)
+
+	pd, err = Parse("ABC", "%Z")
+
+	c.Assert(err, IsNil)
+
+	zn, _ := pd.Zone()
+
+	c.Assert(zn, Equals, "ABC")
+}
+
+func (s *TimeUtilSuite) TestDateMath(c *C) {
+	d := time.Date(2017, 3, 8, 14, 5, 9, 123, time.UTC)
+
+	c.Assert(StartOfDay(d), Equals, time.Date(2017, 3, 8, 0, 0, 0, 0, time.UTC))
+	c.Assert(StartOfWeek(d), Equals, time.Date(2017, 3, 6, 0, 0, 0, 0, time.UTC))
+	c.Assert(StartOfMonth(d), Equals, time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC))
+	c.Assert(StartOfYear(d), Equals, time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC))
+	c.Assert(EndOfDay(d), Equals, time.Date(2017, 3, 8, 23, 59, 59, 999999999, time.UTC))
+	c.Assert(EndOfWeek(d), Equals, time.Date(2017, 3, 12, 23, 59, 59, 999999999, time.UTC))
+	c.Assert(EndOfMonth(d), Equals, time.Date(2017, 3, 31, 23, 59, 59, 999999999, time.UTC))
+	c.Assert(EndOfYear(d), Equals, time.Date(2017, 12, 31, 23, 59, 59, 999999999, time.UTC))
+
+	FirstWeekday = time.Sunday
+
+	c.Assert(StartOfWeek(d), Equals, time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC))
+	c.Assert(EndOfWeek(d), Equals, time.Date(2017, 3, 11, 23, 59, 59, 999999999, time.UTC))
+	c.Assert(StartOfWeek(time.Date(2017, 3, 5, 10, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC))
+
+	FirstWeekday = time.Monday
+
+	c.Assert(StartOfWeek(time.Date(2017, 3, 5, 10, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 2, 27, 0, 0, 0, 0, time.UTC))
+
+	c.Assert(PrevDay(d), Equals, time.Date(2017, 3, 7, 14, 5, 9, 123, time.UTC))
+	c.Assert(NextDay(d), Equals, time.Date(2017, 3, 9, 14, 5, 9, 123, time.UTC))
+	c.Assert(NextMonth(time.Date(2017, 1, 31, 10, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 2, 28, 10, 0, 0, 0, time.UTC))
+	c.Assert(PrevMonth(time.Date(2016, 3, 31, 10, 0, 0, 0, time.UTC)), Equals, time.Date(2016, 2, 29, 10, 0, 0, 0, time.UTC))
+	c.Assert(NextMonth(time.Date(2016, 12, 15, 10, 0, 0, 0, time.UTC)), Equals, time.Date(2017, 1, 15, 10, 0, 0, 0, time.UTC))
+
+	c.Assert(DaysInMonth(time.Date(2016, 2, 10, 0, 0, 0, 0, time.UTC)), Equals, 29)
+	c.Assert(DaysInMonth(time.Date(2017, 2, 10, 0, 0, 0, 0, time.UTC)), Equals, 28)
+	c.Assert(DaysInMonth(time.Date(2017, 12, 10, 0, 0, 0, 0, time.UTC)), Equals, 31)
+
+	c.Assert(IsWeekend(d), Equals, false)
+	c.Assert(IsWeekend(time.Date(2017, 3, 11, 0, 0, 0, 0, time.UTC)), Equals, true)
+	c.Assert(IsWeekend(time.Date(2017, 3, 12, 0, 0, 0, 0, time.UTC)), Equals, true)
+
+	c.Assert(AddWorkdays(d, 0), Equals, d)
+	c.Assert(AddWorkdays(d, 2), Equals, time.Date(2017, 3, 10, 14, 5, 9, 123, time.UTC))
+	c.Assert(AddWorkdays(d, 3), Equals, time.Date(2017, 3, 13, 14, 5, 9, 123, time.UTC))
+	c.Assert(AddWorkdays(d, -3), Equals, time.Date(2017, 3, 3, 14, 5, 9, 123, time.UTC))
+	c.Assert(AddWorkdays(time.Date(2017, 3, 11, 9, 0, 0, 0, time.UTC), 1), Equals, time.Date(2017, 3, 13, 9, 0, 0, 0, time.UTC))
+
+	loc, err := time.LoadLocation("Europe/Moscow")
+
+	if err != nil {
+		c.Skip("Timezone info is not available")
+	}
+
+	// DST transition in Moscow (2011-03-27 02:00 → 03:00)
+	dst := time.Date(2011, 3, 27, 12, 0, 0, 0, loc)
+
+	c.Assert(StartOfDay(dst), Equals, time.Date(2011, 3, 27, 0, 0, 0, 0, loc))
+	c.Assert(NextDay(StartOfDay(dst)), Equals, time.Date(2011, 3, 28, 0, 0, 0, 0, loc))
+}
+
+func (s *TimeUtilSuite) TestPrettyRelative(c *C) {
+	now := time.Date(2017, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	c.Assert(PrettyRelativeSpecial(nil, now, now), Equals, "just now")
+	c.Assert(PrettyRelativeSpecial(nil, now.Add(-200*time.Millisecond), now), Equals, "just now")
+	c.Assert(PrettyRelativeSpecial(nil, now.Add(-time.Second), now), Equals, "1 second ago")
+	c.Assert(PrettyRelativeSpecial(nil, now.Add(-45*time.Second), now), Equals, "45 seconds ago")
+	c.Assert(PrettyRelativeSpecial(nil, now.Add(-3*time.Minute), now), Equals, "3 minutes ago")
+	c.Assert(PrettyRelativeSpecial(nil, now.Add(-90*time.Minute), now), Equals, "1 hour ago")
+	c.Assert(PrettyRelativeSpecial(nil, now.Add(48*time.Hour), now), Equals, "in 2 days")
+	c.Assert(PrettyRelativeSpecial(nil, now.Add(48*time.Hour-time.Millisecond), now), Equals, "in 2 days")
+	c.Assert(PrettyRelativeSpecial(nil, now.AddDate(0, 0, -15), now), Equals, "2 weeks ago")
+	c.Assert(PrettyRelativeSpecial(nil, now.AddDate(0, 0, 65), now), Equals, "in 2 months")
+	c.Assert(PrettyRelativeSpecial(nil, now.AddDate(-3, 0, 0), now), Equals, "3 years ago")
+
+	ru := &RelativeLocale{
+		Pluralizer: pluralize.Ru,
+		Now:        "сейчас",
+		Past:       "%s назад",
+		Future:     "через %s",
+		Seconds:    []string{"секунду", "секунды", "секунд"},
+		Minutes:    []string{"минуту", "минуты", "минут"},
+		Hours:      []string{"час", "часа", "часов"},
+		Days:       []string{"день", "дня", "дней"},
+		Weeks:      []string{"неделю", "недели", "недель"},
+		Months:     []string{"месяц", "месяца", "месяцев"},
+		Years:      []string{"год", "года", "лет"},
+	}
+
+	c.Assert(PrettyRelativeSpecial(ru, now, now), Equals, "сейчас")
+	c.Assert(PrettyRelativeSpecial(ru, now.Add(-5*time.Minute), now), Equals, "5 минут назад")
+	c.Assert(PrettyRelativeSpecial(ru, now.Add(3*time.Hour), now), Equals, "через 3 часа")
+
+	c.Assert(PrettyRelative(time.Now().Add(-3*time.Minute)), Equals, "3 minutes ago")
 }
@@ -7,6 +7,12 @@ package usage
 //                                                                                    //
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+import (
+	"pkg.re/essentialkaos/ek.v7/usage/update"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
 func ExampleAbout_Render() {
 	about := About{
 		App:     "MySupperApp",
@@ -17,6 +23,10 @@ func ExampleAbout_Render() {
 		Year:    2009,    // Year when company was founded
 		License: "MIT",
 		Owner:   "John Dow <john@domain.com>",
+
+		// Info about new releases on GitHub will be shown in about
+		// info (info about latest release is cached for 24 hours)
+		UpdateChecker: UpdateChecker{"essentialkaos/myapp", update.GitHubChecker},
 	}
 
 	about.Render()
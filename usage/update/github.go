@@ -8,6 +8,10 @@ package update
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -21,14 +25,36 @@ type githubRelease struct {
 	Published time.Time `json:"published_at"`
 }
 
+type cachedRelease struct {
+	Release *githubRelease `json:"release"`
+	Checked time.Time      `json:"checked"`
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// GitHubChecker check new releases on github
+// CacheDir is path to per-user directory for caching info about latest
+// releases (if empty, info is not cached)
+var CacheDir = getDefaultCacheDir()
+
+// CacheTTL is maximum age of cached info about latest release
+var CacheTTL = 24 * time.Hour
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// GitHubChecker check new releases on github (data is repository
+// name, e.g. "essentialkaos/ek"). Info about latest release is cached
+// in CacheDir for CacheTTL.
 func GitHubChecker(app, version, data string) (string, time.Time, bool) {
-	ghRelease := getLatestGitHubRelease(app, version, data)
+	ghRelease := readCachedRelease(data)
 
 	if ghRelease == nil {
-		return "", time.Time{}, false
+		ghRelease = getLatestGitHubRelease(app, version, data)
+
+		if ghRelease == nil {
+			return "", time.Time{}, false
+		}
+
+		writeCachedRelease(data, ghRelease)
 	}
 
 	return strings.TrimLeft(ghRelease.Tag, "v"), ghRelease.Published, true
@@ -67,3 +93,89 @@ func getLatestGitHubRelease(app, version, repository string) *githubRelease {
 
 	return ghRelease
 }
+
+// readCachedRelease read info about latest release from cache
+func readCachedRelease(repository string) *githubRelease {
+	if CacheDir == "" {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(getCacheFile(repository))
+
+	if err != nil {
+		return nil
+	}
+
+	cache := &cachedRelease{}
+
+	err = json.Unmarshal(data, cache)
+
+	if err != nil || cache.Release == nil || time.Since(cache.Checked) > CacheTTL {
+		return nil
+	}
+
+	return cache.Release
+}
+
+// writeCachedRelease save info about latest release to cache
+func writeCachedRelease(repository string, release *githubRelease) {
+	if CacheDir == "" {
+		return
+	}
+
+	data, err := json.Marshal(&cachedRelease{release, time.Now()})
+
+	if err != nil {
+		return
+	}
+
+	err = os.MkdirAll(CacheDir, 0700)
+
+	if err != nil {
+		return
+	}
+
+	// Data is written to temporary file with unique name and then renamed,
+	// so we never follow symlinks and never leave partially written cache
+	fd, err := ioutil.TempFile(CacheDir, ".ek-update-")
+
+	if err != nil {
+		return
+	}
+
+	_, err = fd.Write(data)
+	fd.Close()
+
+	if err != nil {
+		os.Remove(fd.Name())
+		return
+	}
+
+	err = os.Rename(fd.Name(), getCacheFile(repository))
+
+	if err != nil {
+		os.Remove(fd.Name())
+	}
+}
+
+// getCacheFile return path to cache file for given repository
+func getCacheFile(repository string) string {
+	return filepath.Join(CacheDir, strings.Replace(repository, "/", "-", -1)+".json")
+}
+
+// getDefaultCacheDir return path to per-user cache directory
+func getDefaultCacheDir() string {
+	cacheDir := os.Getenv("XDG_CACHE_HOME")
+
+	if cacheDir == "" {
+		homeDir := os.Getenv("HOME")
+
+		if homeDir == "" {
+			return ""
+		}
+
+		cacheDir = filepath.Join(homeDir, ".cache")
+	}
+
+	return filepath.Join(cacheDir, "ek-update")
+}
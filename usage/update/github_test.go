@@ -0,0 +1,83 @@
+package update
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	. "pkg.re/check.v1"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func Test(t *testing.T) { TestingT(t) }
+
+type UpdateSuite struct {
+	cacheDir string
+	cacheTTL time.Duration
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var _ = Suite(&UpdateSuite{})
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+func (s *UpdateSuite) SetUpTest(c *C) {
+	s.cacheDir, s.cacheTTL = CacheDir, CacheTTL
+	CacheDir, CacheTTL = c.MkDir(), time.Hour
+}
+
+func (s *UpdateSuite) TearDownTest(c *C) {
+	CacheDir, CacheTTL = s.cacheDir, s.cacheTTL
+}
+
+func (s *UpdateSuite) TestCacheHit(c *C) {
+	published := time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	c.Assert(readCachedRelease("essentialkaos/ek"), IsNil)
+
+	writeCachedRelease("essentialkaos/ek", &githubRelease{"v7.1.0", published})
+
+	release := readCachedRelease("essentialkaos/ek")
+
+	c.Assert(release, NotNil)
+	c.Assert(release.Tag, Equals, "v7.1.0")
+	c.Assert(release.Published.Equal(published), Equals, true)
+	c.Assert(readCachedRelease("essentialkaos/other"), IsNil)
+}
+
+func (s *UpdateSuite) TestCacheExpired(c *C) {
+	data, err := json.Marshal(&cachedRelease{
+		&githubRelease{"v7.1.0", time.Now()},
+		time.Now().Add(-2 * time.Hour),
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(ioutil.WriteFile(getCacheFile("essentialkaos/ek"), data, 0600), IsNil)
+	c.Assert(readCachedRelease("essentialkaos/ek"), IsNil)
+}
+
+func (s *UpdateSuite) TestCacheCorrupted(c *C) {
+	c.Assert(ioutil.WriteFile(getCacheFile("essentialkaos/ek"), []byte("{\"release\":"), 0600), IsNil)
+	c.Assert(readCachedRelease("essentialkaos/ek"), IsNil)
+
+	c.Assert(ioutil.WriteFile(getCacheFile("essentialkaos/ek"), []byte("{}"), 0600), IsNil)
+	c.Assert(readCachedRelease("essentialkaos/ek"), IsNil)
+}
+
+func (s *UpdateSuite) TestCacheDisabled(c *C) {
+	CacheDir = ""
+
+	writeCachedRelease("essentialkaos/ek", &githubRelease{"v7.1.0", time.Now()})
+
+	c.Assert(readCachedRelease("essentialkaos/ek"), IsNil)
+}
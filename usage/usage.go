@@ -15,18 +15,19 @@ import (
 	"time"
 
 	"pkg.re/essentialkaos/ek.v7/fmtc"
+	"pkg.re/essentialkaos/ek.v7/fmtutil"
+	"pkg.re/essentialkaos/ek.v7/terminal/window"
 	"pkg.re/essentialkaos/ek.v7/version"
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-const (
-	_SPACES = "                                                                "
-	_DOTS   = "................................................................"
-)
-
 const _BREADCRUMBS_MIN_SIZE = 16
 
+// _MIN_DESC_SIZE is minimal size of description part of line required
+// for wrapping descriptions
+const _MIN_DESC_SIZE = 24
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // About contains info about application
@@ -49,6 +50,7 @@ type Info struct {
 	CommandsColorTag string // CommandsColor contains default commands color
 	OptionsColorTag  string // OptionsColor contains default options color
 	Breadcrumbs      bool   // Use bread crumbs for commands and options output
+	Width            int    // Max line width for wrapping (if 0 terminal window width is used)
 
 	name     string
 	args     string
@@ -161,21 +163,23 @@ func (info *Info) Render() {
 
 	fmtc.Println(usageMessage)
 
+	width := info.getWidth()
+
 	if info.spoiler != "" {
 		fmtc.NewLine()
-		fmtc.Println(info.spoiler)
+		fmtc.Println(wrapText(info.spoiler, 0, width))
 	}
 
 	if len(info.commands) != 0 {
-		renderOptions(info.commands, info.CommandsColorTag, info.Breadcrumbs)
+		renderOptions(info.commands, info.CommandsColorTag, info.Breadcrumbs, width)
 	}
 
 	if len(info.options) != 0 {
-		renderOptions(info.options, info.OptionsColorTag, info.Breadcrumbs)
+		renderOptions(info.options, info.OptionsColorTag, info.Breadcrumbs, width)
 	}
 
 	if len(info.examples) != 0 {
-		renderExamples(info)
+		renderExamples(info, width)
 	}
 
 	fmtc.NewLine()
@@ -273,7 +277,7 @@ func parseOption(option string) string {
 }
 
 // renderOptions render options
-func renderOptions(options []option, colorTag string, breadcrumbs bool) {
+func renderOptions(options []option, colorTag string, breadcrumbs bool, width int) {
 	var (
 		curGroup string
 		opt      option
@@ -295,14 +299,17 @@ func renderOptions(options []option, colorTag string, breadcrumbs bool) {
 		}
 
 		fmtc.Printf(getOptionSeparator(opt, maxSize, breadcrumbs))
-		fmtc.Printf(opt.desc)
+
+		// Description starts after option name with separator, so
+		// all wrapped lines are aligned by this offset
+		fmtc.Printf(wrapText(opt.desc, maxSize+4, width))
 
 		fmtc.NewLine()
 	}
 }
 
 // renderExamples render examples
-func renderExamples(info *Info) {
+func renderExamples(info *Info, width int) {
 	printGroupHeader("Examples")
 
 	total := len(info.examples)
@@ -311,7 +318,7 @@ func renderExamples(info *Info) {
 		fmtc.Printf("  %s %s\n", info.name, example.cmd)
 
 		if example.desc != "" {
-			fmtc.Printf("  {s-}%s{!}\n", example.desc)
+			fmtc.Printf("  {s-}%s{!}\n", wrapText(example.desc, 2, width))
 		}
 
 		if index < total-1 {
@@ -356,10 +363,10 @@ func getOptionSeparator(opt option, maxSize int, breadcrumbs bool) string {
 	optLen := len(opt.name) + getRenderedArgsSize(opt.args)
 
 	if breadcrumbs && !fmtc.DisableColors && maxSize > _BREADCRUMBS_MIN_SIZE {
-		return " {s-}" + _DOTS[:maxSize-optLen] + "{!} "
+		return " {s-}" + strings.Repeat(".", maxSize-optLen) + "{!} "
 	}
 
-	return " " + _SPACES[:maxSize-optLen] + " "
+	return " " + strings.Repeat(" ", maxSize-optLen) + " "
 }
 
 // getMaxOptionSize return longest option name size
@@ -378,6 +385,28 @@ func getMaxOptionSize(options []option) int {
 	return result
 }
 
+// getWidth return max line width
+func (info *Info) getWidth() int {
+	if info.Width > 0 {
+		return info.Width
+	}
+
+	return window.GetWidth()
+}
+
+// wrapText wrap text to given width, all lines except first are indented by
+// given number of spaces (first line is printed after some other data with
+// the same size)
+func wrapText(text string, indent, width int) string {
+	if width <= 0 || width-indent < _MIN_DESC_SIZE {
+		return text
+	}
+
+	spaces := strings.Repeat(" ", indent)
+
+	return strings.TrimPrefix(fmtutil.Wrap(text, spaces, width), spaces)
+}
+
 // printGroupHeader print category header
 func printGroupHeader(name string) {
 	fmtc.Printf("\n{*}%s{!}\n\n", name)
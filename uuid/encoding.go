@@ -0,0 +1,102 @@
+package uuid
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"encoding/base64"
+	"math/big"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// base62Alphabet contains symbols used for base62 encoding
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// base62Size is size of base62 encoded UUID
+const base62Size = 22
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// EncodeBase62 encode UUID to 22 symbols base62 string
+func EncodeBase62(u UUID) string {
+	buf := []byte(strings.Repeat("0", base62Size))
+	num := new(big.Int).SetBytes(u[:])
+	mod := new(big.Int)
+	base := big.NewInt(62)
+
+	for index := base62Size - 1; num.Sign() > 0; index-- {
+		num.DivMod(num, base, mod)
+		buf[index] = base62Alphabet[mod.Int64()]
+	}
+
+	return string(buf)
+}
+
+// DecodeBase62 decode UUID from base62 string
+func DecodeBase62(s string) (UUID, error) {
+	if len(s) != base62Size {
+		return UUID{}, ErrInvalidFormat
+	}
+
+	num := new(big.Int)
+	base := big.NewInt(62)
+
+	for _, r := range s {
+		index := strings.IndexRune(base62Alphabet, r)
+
+		if index == -1 {
+			return UUID{}, ErrInvalidFormat
+		}
+
+		num.Mul(num, base)
+		num.Add(num, big.NewInt(int64(index)))
+	}
+
+	// Value doesn't fit into 128 bits
+	if num.BitLen() > 128 {
+		return UUID{}, ErrInvalidFormat
+	}
+
+	var uuid UUID
+
+	data := num.Bytes()
+	copy(uuid[16-len(data):], data)
+
+	return uuid, nil
+}
+
+// EncodeBase64 encode UUID to 22 symbols URL-safe base64 string
+// (without padding)
+func EncodeBase64(u UUID) string {
+	return base64.RawURLEncoding.EncodeToString(u[:])
+}
+
+// DecodeBase64 decode UUID from URL-safe base64 string
+func DecodeBase64(s string) (UUID, error) {
+	if len(s) != base62Size {
+		return UUID{}, ErrInvalidFormat
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+
+	if err != nil || len(data) != 16 {
+		return UUID{}, ErrInvalidFormat
+	}
+
+	var uuid UUID
+
+	copy(uuid[:], data)
+
+	// Last symbol can contain non-zero unused bits
+	if EncodeBase64(uuid) != s {
+		return UUID{}, ErrInvalidFormat
+	}
+
+	return uuid, nil
+}
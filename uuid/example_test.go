@@ -17,10 +17,84 @@ func ExampleGenUUID() {
 	fmt.Printf("UUID: %s\n", GenUUID())
 }
 
+func ExampleGenUUID1() {
+	fmt.Printf("UUID v1: %s\n", GenUUID1())
+}
+
+func ExampleGenUUID3() {
+	fmt.Printf("UUID v3: %s\n", GenUUID3(NsDNS, "www.example.com"))
+
+	// Output: UUID v3: 5df41881-3aed-3515-88a7-2f4a814cf09e
+}
+
 func ExampleGenUUID4() {
 	fmt.Printf("UUID v4: %s\n", GenUUID4())
 }
 
+func ExampleGenBatch() {
+	for _, uuid := range GenBatch(3) {
+		fmt.Printf("UUID v4: %s\n", uuid)
+	}
+}
+
 func ExampleGenUUID5() {
 	fmt.Printf("UUID v5: %s\n", GenUUID5(NsURL, "http://www.domain.com"))
 }
+
+func ExampleGenUUID7() {
+	fmt.Printf("UUID v7: %s\n", GenUUID7())
+}
+
+func ExampleParse() {
+	u, err := Parse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("UUID: %s\n", u)
+	fmt.Printf("Version: %d\n", u.Version())
+	fmt.Printf("Variant: %d\n", u.Variant())
+
+	// Output:
+	// UUID: 6ba7b811-9dad-11d1-80b4-00c04fd430c8
+	// Version: 1
+	// Variant: 1
+}
+
+func ExampleValidate() {
+	fmt.Println(Validate("6ba7b811-9dad-11d1-80b4-00c04fd430c8"))
+	fmt.Println(Validate("6ba7b811-9dad-11d1"))
+
+	// Output:
+	// <nil>
+	// Invalid UUID format
+}
+
+func ExampleEncodeBase62() {
+	u, _ := Parse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	s := EncodeBase62(u)
+	du, _ := DecodeBase62(s)
+
+	fmt.Println(du)
+
+	// Output: 6ba7b811-9dad-11d1-80b4-00c04fd430c8
+}
+
+func ExampleEncodeBase64() {
+	u, _ := Parse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+
+	fmt.Println(EncodeBase64(u))
+
+	// Output: a6e4EZ2tEdGAtADAT9QwyA
+}
+
+func ExampleUUID_MarshalJSON() {
+	u, _ := Parse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	data, _ := u.MarshalJSON()
+
+	fmt.Println(string(data))
+
+	// Output: "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+}
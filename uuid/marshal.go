@@ -0,0 +1,84 @@
+package uuid
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Scan implements sql.Scanner interface
+func (u *UUID) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*u = UUID{}
+		return nil
+
+	case string:
+		if value == "" {
+			*u = UUID{}
+			return nil
+		}
+
+		uuid, err := Parse(value)
+
+		if err != nil {
+			return err
+		}
+
+		*u = uuid
+
+		return nil
+
+	case []byte:
+		if len(value) == 16 {
+			copy(u[:], value)
+			return nil
+		}
+
+		return u.Scan(string(value))
+	}
+
+	return fmt.Errorf("Can't scan value of type %T into UUID", src)
+}
+
+// Value implements driver.Valuer interface
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// MarshalJSON implements json.Marshaler interface
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + u.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	value, err := strconv.Unquote(string(data))
+
+	if err != nil {
+		return ErrInvalidFormat
+	}
+
+	uuid, err := Parse(value)
+
+	if err != nil {
+		return err
+	}
+
+	*u = uuid
+
+	return nil
+}
@@ -1,4 +1,5 @@
-// Package uuid contains methods for generating version 4 and 5 UUID's
+// Package uuid contains methods for generating version 1, 3, 4, 5 and 7 UUID's and
+// parsing UUID's
 package uuid
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -9,9 +10,73 @@ package uuid
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"crypto/md5"
 	"crypto/rand"
 	"crypto/sha1"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// UUID variants
+const (
+	VARIANT_NCS       = 0 // Reserved, NCS backward compatibility
+	VARIANT_RFC4122   = 1 // RFC 4122 variant
+	VARIANT_MICROSOFT = 2 // Reserved, Microsoft Corporation backward compatibility
+	VARIANT_FUTURE    = 3 // Reserved for future definition
+)
+
+// gregorianOffset is number of 100-nanosecond intervals between UUID
+// epoch (15 October 1582) and Unix epoch
+const gregorianOffset = 0x01B21DD213814000
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// UUID contains UUID data
+type UUID [16]byte
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var (
+	// ErrInvalidFormat is returned if given string is not valid UUID
+	ErrInvalidFormat = errors.New("Invalid UUID format")
+
+	// ErrInvalidNodeID is returned if node ID has wrong size
+	ErrInvalidNodeID = errors.New("Node ID must be 6 bytes long")
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// v1State contains state of version 1 UUID generator
+type v1State struct {
+	node     []byte
+	clockSeq uint16
+	lastTime uint64
+	mu       *sync.Mutex
+	isInited bool
+}
+
+// v7State contains state of version 7 UUID generator
+type v7State struct {
+	lastTime uint64
+	counter  uint16
+	mu       *sync.Mutex
+}
+
+var (
+	v1 = &v1State{mu: &sync.Mutex{}}
+	v7 = &v7State{mu: &sync.Mutex{}}
+)
+
+var (
+	entropy   io.Reader = rand.Reader
+	entropyMu           = &sync.Mutex{}
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -31,11 +96,82 @@ func GenUUID() string {
 	return GenUUID4()
 }
 
+// GenUUID1 generate time-based UUID. By default random node ID is used,
+// use SetNodeID for using custom node ID (e.g. MAC address).
+func GenUUID1() string {
+	uuid := make([]byte, 16)
+
+	v1.mu.Lock()
+
+	if !v1.isInited {
+		v1.init()
+	}
+
+	ts := uint64(time.Now().UnixNano()/100) + gregorianOffset
+
+	// Clock sequence must be changed if clock is set backwards or
+	// if UUID was already generated in this interval
+	if ts <= v1.lastTime {
+		v1.clockSeq = (v1.clockSeq + 1) & 0x3fff
+	}
+
+	v1.lastTime = ts
+
+	binary.BigEndian.PutUint32(uuid[0:], uint32(ts))
+	binary.BigEndian.PutUint16(uuid[4:], uint16(ts>>32))
+	binary.BigEndian.PutUint16(uuid[6:], uint16(ts>>48))
+	binary.BigEndian.PutUint16(uuid[8:], v1.clockSeq)
+	copy(uuid[10:], v1.node)
+
+	v1.mu.Unlock()
+
+	uuid[6] = (uuid[6] & 0x0f) | 0x10
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return toString(uuid)
+}
+
+// SetNodeID set node ID (6 bytes, usually MAC address) used for
+// generating version 1 UUID's
+func SetNodeID(node []byte) error {
+	if len(node) != 6 {
+		return ErrInvalidNodeID
+	}
+
+	v1.mu.Lock()
+
+	if !v1.isInited {
+		v1.init()
+	}
+
+	v1.node = append([]byte{}, node...)
+
+	v1.mu.Unlock()
+
+	return nil
+}
+
+// GenUUID3 generate UUID based on MD5 hash of namespace UUID and name
+func GenUUID3(ns []byte, name string) string {
+	uuid := make([]byte, 16)
+
+	hash := md5.New()
+	hash.Write(ns[:])
+	hash.Write([]byte(name))
+
+	copy(uuid[:], hash.Sum(nil))
+
+	uuid[6] = (uuid[6] & 0x0f) | 0x30
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return toString(uuid)
+}
+
 // GenUUID4 generate random generated UUID
 func GenUUID4() string {
 	uuid := make([]byte, 16)
 
-	rand.Read(uuid)
+	readRandom(uuid)
 
 	uuid[6] = (uuid[6] & 0x0f) | 0x40
 	uuid[8] = (uuid[8] & 0x3f) | 0x80
@@ -43,6 +179,31 @@ func GenUUID4() string {
 	return toString(uuid)
 }
 
+// GenBatch generate given number of v4 UUID's. All random data for batch
+// is read from entropy source at once, so it's much faster than calling
+// GenUUID4 in loop.
+func GenBatch(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	buf := make([]byte, n*16)
+	result := make([]string, n)
+
+	readRandom(buf)
+
+	for index := range result {
+		uuid := buf[index*16 : (index+1)*16]
+
+		uuid[6] = (uuid[6] & 0x0f) | 0x40
+		uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+		result[index] = toString(uuid)
+	}
+
+	return result
+}
+
 // GenUUID5 generate UUID based on SHA-1 hash of namespace UUID and name
 func GenUUID5(ns []byte, name string) string {
 	uuid := make([]byte, 16)
@@ -59,8 +220,161 @@ func GenUUID5(ns []byte, name string) string {
 	return toString(uuid)
 }
 
+// GenUUID7 generate time-ordered UUID based on Unix timestamp in milliseconds
+// (RFC 9562). UUID's generated in same millisecond contain monotonic counter,
+// so they are always sorted in generation order.
+func GenUUID7() string {
+	uuid := make([]byte, 16)
+
+	readRandom(uuid)
+
+	v7.mu.Lock()
+
+	ts := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+
+	if ts <= v7.lastTime {
+		v7.counter++
+
+		// Counter overflow, so we borrow next millisecond
+		if v7.counter > 0x0fff {
+			v7.lastTime++
+			v7.counter = 0
+		}
+
+		ts = v7.lastTime
+	} else {
+		// Random initial counter value with highest bit cleared for
+		// reserving space for increments
+		v7.counter = binary.BigEndian.Uint16(uuid[6:]) & 0x07ff
+		v7.lastTime = ts
+	}
+
+	counter := v7.counter
+
+	v7.mu.Unlock()
+
+	binary.BigEndian.PutUint16(uuid[0:], uint16(ts>>32))
+	binary.BigEndian.PutUint32(uuid[2:], uint32(ts))
+	binary.BigEndian.PutUint16(uuid[6:], counter)
+
+	uuid[6] = (uuid[6] & 0x0f) | 0x70
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return toString(uuid)
+}
+
+// Parse parse UUID string in canonical form (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx),
+// braced form ({xxxxxxxx-...}), URN form (urn:uuid:xxxxxxxx-...) or as
+// 32 hex symbols
+func Parse(s string) (UUID, error) {
+	var uuid UUID
+
+	switch {
+	case len(s) == 38 && s[0] == '{' && s[37] == '}':
+		s = s[1:37]
+	case len(s) == 45 && strings.EqualFold(s[:9], "urn:uuid:"):
+		s = s[9:]
+	}
+
+	switch len(s) {
+	case 32:
+		// hex symbols without dashes
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return UUID{}, ErrInvalidFormat
+		}
+
+		s = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	default:
+		return UUID{}, ErrInvalidFormat
+	}
+
+	_, err := hex.Decode(uuid[:], []byte(s))
+
+	if err != nil {
+		return UUID{}, ErrInvalidFormat
+	}
+
+	return uuid, nil
+}
+
+// Validate check UUID string and return error if it's not valid UUID
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// SetEntropySource set source of random data used for generating UUID's
+// (e.g. deterministic source for tests). If source is nil, crypto/rand
+// reader will be used.
+func SetEntropySource(source io.Reader) {
+	entropyMu.Lock()
+
+	if source == nil {
+		source = rand.Reader
+	}
+
+	entropy = source
+
+	entropyMu.Unlock()
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Version return UUID version
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Variant return UUID variant
+func (u UUID) Variant() int {
+	switch {
+	case u[8]&0x80 == 0:
+		return VARIANT_NCS
+	case u[8]&0xC0 == 0x80:
+		return VARIANT_RFC4122
+	case u[8]&0xE0 == 0xC0:
+		return VARIANT_MICROSOFT
+	}
+
+	return VARIANT_FUTURE
+}
+
+// String return UUID in canonical form
+func (u UUID) String() string {
+	return toString(u[:])
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
+// readRandom fill given slice with data from entropy source
+func readRandom(buf []byte) {
+	entropyMu.Lock()
+	_, err := io.ReadFull(entropy, buf)
+	entropyMu.Unlock()
+
+	// UUID based on zeroed or partially filled buffer is not unique,
+	// so there is no sane way to continue
+	if err != nil {
+		panic("Can't read random data: " + err.Error())
+	}
+}
+
+// init generate random clock sequence and node ID
+func (s *v1State) init() {
+	buf := make([]byte, 8)
+
+	readRandom(buf)
+
+	s.clockSeq = binary.BigEndian.Uint16(buf[:2]) & 0x3fff
+	s.node = buf[2:]
+
+	// Set multicast bit for random node ID (RFC 4122, section 4.5)
+	s.node[0] |= 0x01
+	s.isInited = true
+}
+
+// toString convert UUID bytes to string in canonical form
 func toString(uuid []byte) string {
 	buf := make([]byte, 36)
 
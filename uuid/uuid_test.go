@@ -8,7 +8,10 @@ package uuid
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
+	"time"
 
 	. "pkg.re/check.v1"
 )
@@ -30,22 +33,312 @@ func (s *UUIDSuite) TestGenUUID(c *C) {
 	c.Assert(GenUUID(), Not(Equals), "00000000-0000-0000-0000-000000000000")
 }
 
+func (s *UUIDSuite) TestGenUUID1(c *C) {
+	c.Assert(GenUUID1(), HasLen, 36)
+	c.Assert(GenUUID1(), Not(Equals), GenUUID1())
+
+	u, err := Parse(GenUUID1())
+
+	c.Assert(err, IsNil)
+	c.Assert(u.Version(), Equals, 1)
+	c.Assert(u.Variant(), Equals, VARIANT_RFC4122)
+	c.Assert(u[10]&0x01, Equals, byte(0x01))
+
+	c.Assert(SetNodeID([]byte{1, 2, 3}), Equals, ErrInvalidNodeID)
+	c.Assert(SetNodeID([]byte{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6}), IsNil)
+
+	u1, _ := Parse(GenUUID1())
+	u2, _ := Parse(GenUUID1())
+
+	c.Assert(u1[10:], DeepEquals, []byte{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6})
+	c.Assert(u1, Not(DeepEquals), u2)
+}
+
+func (s *UUIDSuite) TestGenUUID3(c *C) {
+	c.Assert(GenUUID3(NsDNS, "www.example.com"), Equals, "5df41881-3aed-3515-88a7-2f4a814cf09e")
+	c.Assert(GenUUID3(NsURL, "TEST"), Equals, GenUUID3(NsURL, "TEST"))
+	c.Assert(GenUUID3(NsURL, "TEST"), Not(Equals), GenUUID3(NsOID, "TEST"))
+
+	u, _ := Parse(GenUUID3(NsX500, "TEST"))
+
+	c.Assert(u.Version(), Equals, 3)
+	c.Assert(u.Variant(), Equals, VARIANT_RFC4122)
+}
+
 func (s *UUIDSuite) TestGenUUID4(c *C) {
 	c.Assert(GenUUID4(), HasLen, 36)
 	c.Assert(GenUUID4(), Not(Equals), "00000000-0000-0000-0000-000000000000")
 }
 
 func (s *UUIDSuite) TestGenUUID5(c *C) {
+	c.Assert(GenUUID5(NsDNS, "www.example.com"), Equals, "2ed6657d-e927-568b-95e1-2665a8aea6a2")
 	c.Assert(GenUUID5(NsURL, "TEST"), HasLen, 36)
 	c.Assert(GenUUID5(NsURL, "TEST"), Not(Equals), "00000000-0000-0000-0000-000000000000")
 }
 
+func (s *UUIDSuite) TestGenBatch(c *C) {
+	c.Assert(GenBatch(0), IsNil)
+	c.Assert(GenBatch(-1), IsNil)
+
+	batch := GenBatch(100)
+
+	c.Assert(batch, HasLen, 100)
+	c.Assert(batch[0], Not(Equals), batch[99])
+
+	for _, s := range batch {
+		u, err := Parse(s)
+
+		c.Assert(err, IsNil)
+		c.Assert(u.Version(), Equals, 4)
+		c.Assert(u.Variant(), Equals, VARIANT_RFC4122)
+	}
+}
+
+func (s *UUIDSuite) TestEntropySource(c *C) {
+	SetEntropySource(bytes.NewReader(make([]byte, 64)))
+
+	c.Assert(GenUUID4(), Equals, "00000000-0000-4000-8000-000000000000")
+	c.Assert(GenBatch(2), DeepEquals, []string{
+		"00000000-0000-4000-8000-000000000000",
+		"00000000-0000-4000-8000-000000000000",
+	})
+
+	SetEntropySource(nil)
+
+	c.Assert(GenUUID4(), Not(Equals), "00000000-0000-4000-8000-000000000000")
+}
+
+func (s *UUIDSuite) TestBrokenEntropySource(c *C) {
+	defer SetEntropySource(nil)
+
+	SetEntropySource(bytes.NewReader(make([]byte, 8)))
+
+	c.Assert(func() { GenUUID4() }, PanicMatches, "Can't read random data: .*")
+}
+
+func (s *UUIDSuite) TestGenUUID7(c *C) {
+	c.Assert(GenUUID7(), HasLen, 36)
+
+	u, err := Parse(GenUUID7())
+
+	c.Assert(err, IsNil)
+	c.Assert(u.Version(), Equals, 7)
+	c.Assert(u.Variant(), Equals, VARIANT_RFC4122)
+
+	ts := uint64(u[0])<<40 | uint64(u[1])<<32 | uint64(u[2])<<24 |
+		uint64(u[3])<<16 | uint64(u[4])<<8 | uint64(u[5])
+	now := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+
+	c.Assert(ts <= now && ts+1000 > now, Equals, true)
+
+	prev := GenUUID7()
+
+	for i := 0; i < 10000; i++ {
+		cur := GenUUID7()
+		c.Assert(cur > prev, Equals, true, Commentf("%s <= %s", cur, prev))
+		prev = cur
+	}
+}
+
+func (s *UUIDSuite) TestParse(c *C) {
+	u, err := Parse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+
+	c.Assert(err, IsNil)
+	c.Assert(u[:], DeepEquals, NsURL)
+	c.Assert(u.String(), Equals, "6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(u.Version(), Equals, 1)
+	c.Assert(u.Variant(), Equals, VARIANT_RFC4122)
+
+	for _, s := range []string{
+		"6BA7B811-9DAD-11D1-80B4-00C04FD430C8",
+		"{6ba7b811-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8119dad11d180b400c04fd430c8",
+	} {
+		u, err = Parse(s)
+
+		c.Assert(err, IsNil)
+		c.Assert(u.String(), Equals, "6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	}
+
+	u, _ = Parse(GenUUID4())
+	c.Assert(u.Version(), Equals, 4)
+	c.Assert(u.Variant(), Equals, VARIANT_RFC4122)
+
+	u, _ = Parse(GenUUID5(NsDNS, "TEST"))
+	c.Assert(u.Version(), Equals, 5)
+
+	u, _ = Parse("00000000-0000-0000-0000-000000000000")
+	c.Assert(u.Variant(), Equals, VARIANT_NCS)
+
+	u, _ = Parse("00000000-0000-0000-c000-000000000000")
+	c.Assert(u.Variant(), Equals, VARIANT_MICROSOFT)
+
+	u, _ = Parse("00000000-0000-0000-e000-000000000000")
+	c.Assert(u.Variant(), Equals, VARIANT_FUTURE)
+
+	for _, s := range []string{
+		"",
+		"6ba7b811-9dad-11d1-80b4-00c04fd430c",
+		"6ba7b811+9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b811-9dad-11d1-80b4-00c04fd430cZ",
+		"{6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+		"uuid:urn:6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8119dad11d180b400c04fd430cX",
+	} {
+		_, err = Parse(s)
+		c.Assert(err, Equals, ErrInvalidFormat, Commentf("%s", s))
+	}
+
+	c.Assert(Validate("6ba7b811-9dad-11d1-80b4-00c04fd430c8"), IsNil)
+	c.Assert(Validate("6ba7b811-9dad-11d1-80b4"), Equals, ErrInvalidFormat)
+}
+
+func (s *UUIDSuite) TestEncoding(c *C) {
+	var err error
+
+	zero := UUID{}
+	max := UUID{}
+
+	for i := range max {
+		max[i] = 0xFF
+	}
+
+	ns, _ := Parse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+
+	c.Assert(EncodeBase62(zero), Equals, "0000000000000000000000")
+	c.Assert(EncodeBase62(max), Equals, "7n42DGM5Tflk9n8mt7Fhc7")
+	c.Assert(EncodeBase62(ns), HasLen, 22)
+
+	for _, u := range []UUID{zero, max, ns} {
+		var du UUID
+
+		du, err = DecodeBase62(EncodeBase62(u))
+		c.Assert(err, IsNil)
+		c.Assert(du, Equals, u)
+
+		du, err = DecodeBase64(EncodeBase64(u))
+		c.Assert(err, IsNil)
+		c.Assert(du, Equals, u)
+	}
+
+	for i := 0; i < 100; i++ {
+		u, _ := Parse(GenUUID4())
+		du, _ := DecodeBase62(EncodeBase62(u))
+		c.Assert(du, Equals, u)
+	}
+
+	c.Assert(EncodeBase64(ns), Equals, "a6e4EZ2tEdGAtADAT9QwyA")
+
+	_, err = DecodeBase62("")
+	c.Assert(err, Equals, ErrInvalidFormat)
+	_, err = DecodeBase62("000000000000000000000-")
+	c.Assert(err, Equals, ErrInvalidFormat)
+	_, err = DecodeBase62("7n42DGM5Tflk9n8mt7Fhc8")
+	c.Assert(err, Equals, ErrInvalidFormat)
+
+	_, err = DecodeBase64("a6e4EZ2tEdGAtADAT9Qwy")
+	c.Assert(err, Equals, ErrInvalidFormat)
+	_, err = DecodeBase64("a6e4EZ2tEdGAtADAT9Qwy+")
+	c.Assert(err, Equals, ErrInvalidFormat)
+	_, err = DecodeBase64("a6e4EZ2tEdGAtADAT9QwyB")
+	c.Assert(err, Equals, ErrInvalidFormat)
+}
+
+func (s *UUIDSuite) TestSQL(c *C) {
+	ns, _ := Parse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+
+	var u UUID
+
+	c.Assert(u.Scan("6ba7b811-9dad-11d1-80b4-00c04fd430c8"), IsNil)
+	c.Assert(u, Equals, ns)
+
+	u = UUID{}
+
+	c.Assert(u.Scan([]byte("{6ba7b811-9dad-11d1-80b4-00c04fd430c8}")), IsNil)
+	c.Assert(u, Equals, ns)
+
+	u = UUID{}
+
+	c.Assert(u.Scan(ns[:]), IsNil)
+	c.Assert(u, Equals, ns)
+
+	c.Assert(u.Scan(nil), IsNil)
+	c.Assert(u, Equals, UUID{})
+
+	u = ns
+
+	c.Assert(u.Scan(""), IsNil)
+	c.Assert(u, Equals, UUID{})
+
+	c.Assert(u.Scan("6ba7b811-9dad"), Equals, ErrInvalidFormat)
+	c.Assert(u.Scan(123), ErrorMatches, "Can't scan value of type int into UUID")
+
+	value, err := ns.Value()
+
+	c.Assert(err, IsNil)
+	c.Assert(value, Equals, "6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+}
+
+func (s *UUIDSuite) TestJSON(c *C) {
+	type Item struct {
+		ID  UUID  `json:"id"`
+		Ref *UUID `json:"ref"`
+	}
+
+	ns, _ := Parse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+
+	data, err := json.Marshal(&Item{ID: ns})
+
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"id":"6ba7b811-9dad-11d1-80b4-00c04fd430c8","ref":null}`)
+
+	item := &Item{}
+
+	c.Assert(json.Unmarshal(data, item), IsNil)
+	c.Assert(item.ID, Equals, ns)
+	c.Assert(item.Ref, IsNil)
+
+	item = &Item{}
+
+	c.Assert(json.Unmarshal([]byte(`{"id":"{6ba7b811-9dad-11d1-80b4-00c04fd430c8}","ref":"6ba7b8119dad11d180b400c04fd430c8"}`), item), IsNil)
+	c.Assert(item.ID, Equals, ns)
+	c.Assert(*item.Ref, Equals, ns)
+
+	c.Assert(json.Unmarshal([]byte(`{"id":"6ba7b811"}`), item), Equals, ErrInvalidFormat)
+	c.Assert(json.Unmarshal([]byte(`{"id":123}`), item), Equals, ErrInvalidFormat)
+}
+
+func (s *UUIDSuite) BenchmarkGenUUID1(c *C) {
+	for i := 0; i < c.N; i++ {
+		GenUUID1()
+	}
+}
+
+func (s *UUIDSuite) BenchmarkGenUUID3(c *C) {
+	for i := 0; i < c.N; i++ {
+		GenUUID3(NsURL, "TEST")
+	}
+}
+
 func (s *UUIDSuite) BenchmarkGenUUID4(c *C) {
 	for i := 0; i < c.N; i++ {
 		GenUUID4()
 	}
 }
 
+func (s *UUIDSuite) BenchmarkGenBatch(c *C) {
+	for i := 0; i < c.N; i += 100 {
+		GenBatch(100)
+	}
+}
+
+func (s *UUIDSuite) BenchmarkGenUUID7(c *C) {
+	for i := 0; i < c.N; i++ {
+		GenUUID7()
+	}
+}
+
 func (s *UUIDSuite) BenchmarkGenUUID5(c *C) {
 	for i := 0; i < c.N; i++ {
 		GenUUID5(NsURL, "TEST")
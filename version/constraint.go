@@ -0,0 +1,314 @@
+package version
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Constraint contains version constraint data
+type Constraint struct {
+	raw    string
+	groups [][]condition
+}
+
+// condition is single comparison with version
+type condition struct {
+	op      string
+	version Version
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+var (
+	// ErrEmptyConstraint is returned if constraint or one of its parts is empty
+	ErrEmptyConstraint = errors.New("Constraint can't be empty")
+
+	// ErrWildcardOperator is returned if wildcard is used with operator
+	ErrWildcardOperator = errors.New("Wildcard can't be used with operators")
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// operators contains supported comparison operators, longest operators
+// must be placed first
+var operators = []string{">=", "<=", "!=", "==", ">", "<", "="}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// NewConstraint parse constraint string and return constraint struct.
+// Constraint contains comparisons (=, !=, >, >=, <, <=) joined by comma
+// (all comparisons must be satisfied) and "||" (any group of comparisons
+// must be satisfied), e.g. ">=1.2.0, <2.0.0 || >=3.0.0". Also tilde (~1.2.3
+// means >=1.2.3, <1.3.0), caret (^1.2.3 means >=1.2.3, <2.0.0) and wildcard
+// (1.2.x or 1.2.* means >=1.2.0, <1.3.0) ranges are supported.
+func NewConstraint(c string) (Constraint, error) {
+	if strings.TrimSpace(c) == "" {
+		return Constraint{}, ErrEmptyConstraint
+	}
+
+	var groups [][]condition
+
+	for _, group := range strings.Split(c, "||") {
+		var conditions []condition
+
+		for _, part := range strings.Split(group, ",") {
+			conds, err := parseCondition(strings.TrimSpace(part))
+
+			if err != nil {
+				return Constraint{}, err
+			}
+
+			conditions = append(conditions, conds...)
+		}
+
+		groups = append(groups, conditions)
+	}
+
+	return Constraint{raw: c, groups: groups}, nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Check return true if given version satisfies constraint
+func (c Constraint) Check(v Version) bool {
+	if v.raw == "" {
+		return false
+	}
+
+	for _, group := range c.groups {
+		if checkConditions(group, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Latest return greatest version from given slice which satisfies
+// constraint (or empty version if there is no such version)
+func (c Constraint) Latest(versions []Version) Version {
+	var result Version
+
+	for _, v := range versions {
+		if c.Check(v) && (result.raw == "" || Compare(v, result) > 0) {
+			result = v
+		}
+	}
+
+	return result
+}
+
+// String return constraint as string
+func (c Constraint) String() string {
+	return c.raw
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// parseCondition parse single comparison or range
+func parseCondition(c string) ([]condition, error) {
+	if c == "" {
+		return nil, ErrEmptyConstraint
+	}
+
+	op := "="
+
+	for _, o := range operators {
+		if strings.HasPrefix(c, o) {
+			op, c = o, strings.TrimSpace(c[len(o):])
+			break
+		}
+	}
+
+	if op == "==" {
+		op = "="
+	}
+
+	if op == "=" && c != "" && (c[0] == '~' || c[0] == '^') {
+		v, err := parseConstraintVersion(strings.TrimSpace(c[1:]))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if c[0] == '~' {
+			return tildeRange(v), nil
+		}
+
+		return caretRange(v), nil
+	}
+
+	if isWildcard(c) {
+		if op != "=" {
+			return nil, ErrWildcardOperator
+		}
+
+		return wildcardRange(c)
+	}
+
+	v, err := parseConstraintVersion(c)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []condition{{op: op, version: v}}, nil
+}
+
+// parseConstraintVersion parse version used in constraint
+func parseConstraintVersion(v string) (Version, error) {
+	result, err := ParseWithRevision(v)
+
+	if err != nil {
+		return Version{}, fmt.Errorf("Can't parse version \"%s\": %v", v, err)
+	}
+
+	return result, nil
+}
+
+// tildeRange return conditions for tilde range (~1.2.3 := >=1.2.3, <1.3.0
+// and ~1 := >=1.0.0, <2.0.0)
+func tildeRange(v Version) []condition {
+	if v.size == 1 {
+		return makeRange(v, v.Major()+1, 0, 0)
+	}
+
+	return makeRange(v, v.Major(), v.Minor()+1, 0)
+}
+
+// caretRange return conditions for caret range (^1.2.3 := >=1.2.3, <2.0.0,
+// ^0.2.3 := >=0.2.3, <0.3.0 and ^0.0.3 := >=0.0.3, <0.0.4)
+func caretRange(v Version) []condition {
+	switch {
+	case v.Major() != 0 || v.size == 1:
+		return makeRange(v, v.Major()+1, 0, 0)
+	case v.Minor() != 0 || v.size == 2:
+		return makeRange(v, 0, v.Minor()+1, 0)
+	}
+
+	return makeRange(v, 0, 0, v.Patch()+1)
+}
+
+// wildcardRange return conditions for wildcard range (1.2.x := >=1.2.0, <1.3.0)
+func wildcardRange(v string) ([]condition, error) {
+	if strings.Count(v, ".") >= 3 {
+		return nil, fmt.Errorf("Can't parse version \"%s\"", v)
+	}
+
+	var slice []int
+
+	for _, part := range strings.Split(v, ".") {
+		if isWildcardPart(part) {
+			break
+		}
+
+		if strings.ContainsAny(part, "-+") {
+			return nil, fmt.Errorf("Wildcard version \"%s\" can't contain prerelease or build", v)
+		}
+
+		pv, err := parseConstraintVersion(part)
+
+		if err != nil {
+			return nil, err
+		}
+
+		slice = append(slice, pv.Major())
+	}
+
+	for _, part := range strings.Split(v, ".")[len(slice):] {
+		if !isWildcardPart(part) {
+			return nil, fmt.Errorf("Can't parse version \"%s\"", v)
+		}
+	}
+
+	switch len(slice) {
+	case 0:
+		return []condition{{op: ">=", version: makeVersion(0, 0, 0, "")}}, nil
+	case 1:
+		return makeRange(makeVersion(slice[0], 0, 0, ""), slice[0]+1, 0, 0), nil
+	}
+
+	return makeRange(makeVersion(slice[0], slice[1], 0, ""), slice[0], slice[1]+1, 0), nil
+}
+
+// makeRange return conditions for range from given version to version with
+// given components (excluding prereleases of upper bound)
+func makeRange(from Version, major, minor, patch int) []condition {
+	return []condition{
+		{op: ">=", version: from},
+		{op: "<", version: makeVersion(major, minor, patch, "0")},
+	}
+}
+
+// makeVersion create version struct with given components
+func makeVersion(major, minor, patch int, preRelease string) Version {
+	raw := fmt.Sprintf("%d.%d.%d", major, minor, patch)
+
+	if preRelease != "" {
+		raw += "-" + preRelease
+	}
+
+	return Version{
+		raw:        raw,
+		slice:      [4]int{major, minor, patch, 0},
+		preRelease: preRelease,
+		size:       3,
+	}
+}
+
+// isWildcard return true if version contains wildcard
+func isWildcard(v string) bool {
+	for _, part := range strings.Split(v, ".") {
+		if isWildcardPart(part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isWildcardPart return true if version component is wildcard
+func isWildcardPart(part string) bool {
+	return part == "x" || part == "X" || part == "*"
+}
+
+// checkConditions return true if version satisfies all conditions
+func checkConditions(conditions []condition, v Version) bool {
+	for _, cond := range conditions {
+		if !cond.check(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// check return true if version satisfies condition
+func (c condition) check(v Version) bool {
+	result := Compare(v, c.version)
+
+	switch c.op {
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case "!=":
+		return result != 0
+	}
+
+	return result == 0
+}
@@ -86,3 +86,214 @@ func ExampleVersion_Contains() {
 	// 1.0 contains 1.0.1 → true
 	// 1.0 contains 1.1 → false
 }
+
+func ExampleCompare() {
+	v1, _ := Parse("1.1.5")
+	v2, _ := Parse("1.2.0")
+
+	fmt.Println(Compare(v1, v2))
+	fmt.Println(Compare(v2, v1))
+	fmt.Println(Compare(v1, v1))
+
+	// Output:
+	// -1
+	// 1
+	// 0
+}
+
+func ExampleSort() {
+	var versions []Version
+
+	for _, s := range []string{"1.2.0", "0.10.8", "1.2.0-beta1", "1.1.5"} {
+		v, _ := Parse(s)
+		versions = append(versions, v)
+	}
+
+	Sort(versions)
+
+	fmt.Println(versions)
+
+	// Output: [0.10.8 1.1.5 1.2.0-beta1 1.2.0]
+}
+
+func ExampleNewConstraint() {
+	c, err := NewConstraint(">=1.2.0, <2.0.0 || >=3.0.0")
+
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	for _, s := range []string{"1.1.0", "1.4.2", "2.1.0", "3.0.1"} {
+		v, _ := Parse(s)
+		fmt.Printf("%s → %t\n", s, c.Check(v))
+	}
+
+	// Output:
+	// 1.1.0 → false
+	// 1.4.2 → true
+	// 2.1.0 → false
+	// 3.0.1 → true
+}
+
+func ExampleConstraint_Check() {
+	c, _ := NewConstraint("^1.2.3 || 2.1.x")
+
+	for _, s := range []string{"1.2.2", "1.9.0", "2.0.0", "2.1.4"} {
+		v, _ := Parse(s)
+		fmt.Printf("%s → %t\n", s, c.Check(v))
+	}
+
+	// Output:
+	// 1.2.2 → false
+	// 1.9.0 → true
+	// 2.0.0 → false
+	// 2.1.4 → true
+}
+
+func ExampleParseStrict() {
+	_, err := ParseStrict("1.2")
+
+	fmt.Println(err)
+
+	v, _ := Parse("v1.2")
+
+	fmt.Printf("%s → %d.%d.%d (strict: %t)\n", v, v.Major(), v.Minor(), v.Patch(), v.IsStrict())
+
+	// Output:
+	// Version must contain major, minor and patch versions
+	// v1.2 → 1.2.0 (strict: false)
+}
+
+func ExampleValidate() {
+	for _, err := range Validate("01.2.x-beta..1") {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// Numeric identifier can't contain leading zeros at position 1
+	// Invalid character at position 6
+	// Identifier can't be empty at position 13
+}
+
+func ExampleVersion_Normalized() {
+	v, _ := Parse("v7-beta1")
+
+	fmt.Println(v.Normalized())
+
+	// Output: 7.0.0-beta1
+}
+
+func ExampleVersion_Format() {
+	v, _ := Parse("6.12.1")
+
+	fmt.Println(v.Format(2))
+
+	// Output: 6.12
+}
+
+func ExampleVersion_IsStable() {
+	for _, s := range []string{"0.9.1", "1.0.0-rc.1", "1.0.0"} {
+		v, _ := Parse(s)
+		fmt.Printf("%s → %t\n", s, v.IsStable())
+	}
+
+	// Output:
+	// 0.9.1 → false
+	// 1.0.0-rc.1 → false
+	// 1.0.0 → true
+}
+
+func ExampleVersion_PreReleaseIdentifiers() {
+	v, _ := Parse("1.0.0-rc.1")
+
+	fmt.Println(v.PreReleaseIdentifiers())
+
+	// Output: [rc 1]
+}
+
+func ExampleMax() {
+	var versions []Version
+
+	for _, s := range []string{"1.2.0", "0.10.8", "1.9.3"} {
+		v, _ := Parse(s)
+		versions = append(versions, v)
+	}
+
+	fmt.Println(Max(versions))
+
+	// Output: 1.9.3
+}
+
+func ExampleConstraint_Latest() {
+	var versions []Version
+
+	for _, s := range []string{"1.2.0", "2.0.1", "1.9.3", "1.10.0"} {
+		v, _ := Parse(s)
+		versions = append(versions, v)
+	}
+
+	c, _ := NewConstraint("^1.2.0")
+
+	fmt.Println(c.Latest(versions))
+
+	// Output: 1.10.0
+}
+
+func ExampleParseWithRevision() {
+	v, _ := ParseWithRevision("10.0.19041.572")
+
+	fmt.Printf("Major: %d\n", v.Major())
+	fmt.Printf("Minor: %d\n", v.Minor())
+	fmt.Printf("Patch: %d\n", v.Patch())
+	fmt.Printf("Revision: %d\n", v.Revision())
+
+	// Output:
+	// Major: 10
+	// Minor: 0
+	// Patch: 19041
+	// Revision: 572
+}
+
+func ExampleVersion_EqualSemantic() {
+	v1, _ := Parse("1.0.0+build1")
+	v2, _ := Parse("1.0.0+build2")
+
+	fmt.Printf("%s = %s → %t\n", v1, v2, v1.EqualSemantic(v2))
+
+	// Output: 1.0.0+build1 = 1.0.0+build2 → true
+}
+
+func ExampleVersion_SameRelease() {
+	v1, _ := Parse("1.0.0-rc1")
+	v2, _ := Parse("1.0.0")
+
+	fmt.Printf("%s = %s → %t\n", v1, v2, v1.SameRelease(v2))
+
+	// Output: 1.0.0-rc1 = 1.0.0 → true
+}
+
+func ExampleConstraint_Intersects() {
+	c1, _ := NewConstraint("^1.2.0")
+	c2, _ := NewConstraint(">=1.5.0")
+	c3, _ := NewConstraint(">=2.0.0")
+
+	fmt.Println(c1.Intersects(c2))
+	fmt.Println(c1.Intersects(c3))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleConstraint_Subset() {
+	c1, _ := NewConstraint("~1.2.3")
+	c2, _ := NewConstraint("^1.0.0")
+
+	fmt.Println(c1.Subset(c2))
+	fmt.Println(c2.Subset(c1))
+
+	// Output:
+	// true
+	// false
+}
@@ -0,0 +1,250 @@
+package version
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"sort"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// interval is range of versions, empty bound version means that
+// interval is unbounded from this side
+type interval struct {
+	min     Version
+	max     Version
+	minIncl bool
+	maxIncl bool
+}
+
+// intervals is slice of intervals sorted by lower bound
+type intervals []interval
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Intersects return true if there is at least one version which satisfies
+// both constraints
+func (c Constraint) Intersects(constraint Constraint) bool {
+	for _, i1 := range c.intervals() {
+		for _, i2 := range constraint.intervals() {
+			if _, ok := intersectIntervals(i1, i2); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Subset return true if all versions which satisfies constraint also
+// satisfies given constraint
+func (c Constraint) Subset(constraint Constraint) bool {
+	merged := mergeIntervals(constraint.intervals())
+
+MAINLOOP:
+	for _, i1 := range c.intervals() {
+		for _, i2 := range merged {
+			if i2.contains(i1) {
+				continue MAINLOOP
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Len return number of intervals
+func (s intervals) Len() int {
+	return len(s)
+}
+
+// Less return true if interval with index i starts before interval with index j
+func (s intervals) Less(i, j int) bool {
+	return compareMin(s[i], s[j]) < 0
+}
+
+// Swap swap intervals with indexes i and j
+func (s intervals) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// intervals return slice with all intervals of versions which satisfies
+// constraint
+func (c Constraint) intervals() []interval {
+	var result []interval
+
+	for _, group := range c.groups {
+		groupIntervals := []interval{{}}
+
+		for _, cond := range group {
+			var next []interval
+
+			for _, i1 := range groupIntervals {
+				for _, i2 := range cond.intervals() {
+					if i, ok := intersectIntervals(i1, i2); ok {
+						next = append(next, i)
+					}
+				}
+			}
+
+			groupIntervals = next
+		}
+
+		result = append(result, groupIntervals...)
+	}
+
+	return result
+}
+
+// intervals return intervals of versions which satisfies condition
+func (c condition) intervals() []interval {
+	switch c.op {
+	case ">":
+		return []interval{{min: c.version}}
+	case ">=":
+		return []interval{{min: c.version, minIncl: true}}
+	case "<":
+		return []interval{{max: c.version}}
+	case "<=":
+		return []interval{{max: c.version, maxIncl: true}}
+	case "!=":
+		return []interval{{max: c.version}, {min: c.version}}
+	}
+
+	return []interval{{min: c.version, max: c.version, minIncl: true, maxIncl: true}}
+}
+
+// contains return true if interval contains given interval
+func (i interval) contains(ii interval) bool {
+	return compareMin(i, ii) <= 0 && compareMax(i, ii) >= 0
+}
+
+// isEmpty return true if there is no versions in interval
+func (i interval) isEmpty() bool {
+	if i.min.IsZero() || i.max.IsZero() {
+		return false
+	}
+
+	result := Compare(i.min, i.max)
+
+	return result > 0 || result == 0 && !(i.minIncl && i.maxIncl)
+}
+
+// intersectIntervals return intersection of two intervals and false
+// if intersection is empty
+func intersectIntervals(i1, i2 interval) (interval, bool) {
+	result := interval{}
+
+	if compareMin(i1, i2) >= 0 {
+		result.min, result.minIncl = i1.min, i1.minIncl
+	} else {
+		result.min, result.minIncl = i2.min, i2.minIncl
+	}
+
+	if compareMax(i1, i2) <= 0 {
+		result.max, result.maxIncl = i1.max, i1.maxIncl
+	} else {
+		result.max, result.maxIncl = i2.max, i2.maxIncl
+	}
+
+	return result, !result.isEmpty()
+}
+
+// mergeIntervals merge overlapping and adjacent intervals
+func mergeIntervals(list []interval) []interval {
+	if len(list) == 0 {
+		return nil
+	}
+
+	sorted := make(intervals, len(list))
+	copy(sorted, list)
+	sort.Sort(sorted)
+
+	result := []interval{sorted[0]}
+
+	for _, i := range sorted[1:] {
+		last := &result[len(result)-1]
+
+		if !isAdjacent(*last, i) {
+			result = append(result, i)
+			continue
+		}
+
+		if compareMax(i, *last) > 0 {
+			last.max, last.maxIncl = i.max, i.maxIncl
+		}
+	}
+
+	return result
+}
+
+// isAdjacent return true if second interval (which starts after first)
+// overlaps or touches first interval
+func isAdjacent(i1, i2 interval) bool {
+	if i1.max.IsZero() || i2.min.IsZero() {
+		return true
+	}
+
+	result := Compare(i2.min, i1.max)
+
+	return result < 0 || result == 0 && (i1.maxIncl || i2.minIncl)
+}
+
+// compareMin compare lower bounds of intervals
+func compareMin(i1, i2 interval) int {
+	switch {
+	case i1.min.IsZero() && i2.min.IsZero():
+		return 0
+	case i1.min.IsZero():
+		return -1
+	case i2.min.IsZero():
+		return 1
+	}
+
+	result := Compare(i1.min, i2.min)
+
+	if result != 0 || i1.minIncl == i2.minIncl {
+		return result
+	}
+
+	if i1.minIncl {
+		return -1
+	}
+
+	return 1
+}
+
+// compareMax compare upper bounds of intervals
+func compareMax(i1, i2 interval) int {
+	switch {
+	case i1.max.IsZero() && i2.max.IsZero():
+		return 0
+	case i1.max.IsZero():
+		return 1
+	case i2.max.IsZero():
+		return -1
+	}
+
+	result := Compare(i1.max, i2.max)
+
+	if result != 0 || i1.maxIncl == i2.maxIncl {
+		return result
+	}
+
+	if i1.maxIncl {
+		return 1
+	}
+
+	return -1
+}
@@ -0,0 +1,155 @@
+package version
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+//                                                                                    //
+//                     Copyright (c) 2009-2017 ESSENTIAL KAOS                         //
+//        Essential Kaos Open Source License <https://essentialkaos.com/ekol>         //
+//                                                                                    //
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// ValidationError contains info about problem in version string
+type ValidationError struct {
+	Pos int   // Position of problem (starting from 1)
+	Err error // Problem
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Validate check that given string is strict SemVer version and return all
+// found problems
+func Validate(v string) []error {
+	if v == "" {
+		return []error{ErrEmpty}
+	}
+
+	var preErrs, buildErrs []error
+
+	core := v
+
+	if index := strings.Index(core, "+"); index != -1 {
+		buildErrs = validateIdentifiers(core[index+1:], index+1, false)
+		core = core[:index]
+	}
+
+	if index := strings.Index(core, "-"); index != -1 {
+		preErrs = validateIdentifiers(core[index+1:], index+1, true)
+		core = core[:index]
+	}
+
+	errs := validateCore(core)
+	errs = append(errs, preErrs...)
+
+	return append(errs, buildErrs...)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// Error return error message
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v at position %d", e.Err, e.Pos)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// checkStrict check that version string is strict SemVer version and return
+// first found problem
+func checkStrict(v string) error {
+	errs := Validate(v)
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	if vErr, ok := errs[0].(*ValidationError); ok {
+		return vErr.Err
+	}
+
+	return errs[0]
+}
+
+// validateCore check major, minor and patch versions
+func validateCore(v string) []error {
+	var errs []error
+	var offset int
+
+	parts := strings.Split(v, ".")
+
+	for index, part := range parts {
+		if index == 3 {
+			errs = append(errs, &ValidationError{offset + 1, ErrNotFull})
+			break
+		}
+
+		errs = append(errs, validateIdentifier(part, offset, true, true)...)
+		offset += len(part) + 1
+	}
+
+	if len(parts) < 3 {
+		errs = append(errs, &ValidationError{len(v) + 1, ErrNotFull})
+	}
+
+	return errs
+}
+
+// validateIdentifiers check dot-separated prerelease or build identifiers
+func validateIdentifiers(v string, offset int, checkZeros bool) []error {
+	var errs []error
+
+	for _, ident := range strings.Split(v, ".") {
+		errs = append(errs, validateIdentifier(ident, offset, false, checkZeros)...)
+		offset += len(ident) + 1
+	}
+
+	return errs
+}
+
+// validateIdentifier check single identifier
+func validateIdentifier(v string, offset int, numeric, checkZeros bool) []error {
+	if v == "" {
+		return []error{&ValidationError{offset + 1, ErrEmptyIdentifier}}
+	}
+
+	var errs []error
+
+	for index, r := range v {
+		if !isValidIdentChar(r, numeric) {
+			errs = append(errs, &ValidationError{offset + index + 1, ErrInvalidChar})
+		}
+	}
+
+	if errs == nil && checkZeros && isNumeric(v) && len(v) > 1 && v[0] == '0' {
+		errs = append(errs, &ValidationError{offset + 1, ErrLeadingZero})
+	}
+
+	return errs
+}
+
+// isValidIdentChar return true if rune can be used in identifier
+func isValidIdentChar(r rune, numeric bool) bool {
+	switch {
+	case r >= '0' && r <= '9':
+		return true
+	case numeric:
+		return false
+	}
+
+	return r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
+
+// isNumeric return true if string contains only digits
+func isNumeric(v string) bool {
+	for _, r := range v {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -11,6 +11,7 @@ package version
 import (
 	"errors"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -20,18 +21,26 @@ import (
 // Version contains version data
 type Version struct {
 	raw        string
-	slice      [3]int
+	slice      [4]int
 	preRelease string
 	build      string
 	size       int
+	strict     bool
 }
 
+// Versions is slice of versions which implements sort.Interface
+type Versions []Version
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 var (
 	ErrEmpty           = errors.New("Version can't be empty")
 	ErrEmptyBuild      = errors.New("Build number is empty")
 	ErrEmptyPrerelease = errors.New("Prerelease number is empty")
+	ErrNotFull         = errors.New("Version must contain major, minor and patch versions")
+	ErrLeadingZero     = errors.New("Numeric identifier can't contain leading zeros")
+	ErrEmptyIdentifier = errors.New("Identifier can't be empty")
+	ErrInvalidChar     = errors.New("Invalid character")
 )
 
 // ////////////////////////////////////////////////////////////////////////////////// //
@@ -40,15 +49,34 @@ var preRegExp = regexp.MustCompile(`([a-zA-Z-.]{1,})([0-9]{0,})`)
 
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// Parse parse version string and return version struct
+// Parse parse version string and return version struct. Parser is tolerant
+// to surrounding whitespace, "v" prefix and missing minor and patch versions.
+// Use IsStrict method for checking that version string is strict SemVer.
 func Parse(v string) (Version, error) {
+	return parse(v, 3)
+}
+
+// ParseWithRevision parse version string with optional fourth version
+// component (revision), e.g. 1.2.3.4
+func ParseWithRevision(v string) (Version, error) {
+	return parse(v, 4)
+}
+
+// parse parse version string with given max number of version components
+func parse(v string, components int) (Version, error) {
+	v = strings.TrimSpace(v)
+
 	if v == "" {
 		return Version{}, ErrEmpty
 	}
 
-	var slice = [3]int{0, 0, 0}
+	var slice = [4]int{0, 0, 0, 0}
 	var raw = v
 
+	if v[0] == 'v' || v[0] == 'V' {
+		v = v[1:]
+	}
+
 	var (
 		preRelease string
 		build      string
@@ -56,7 +84,7 @@ func Parse(v string) (Version, error) {
 	)
 
 	if strings.Contains(v, "+") {
-		bs := strings.Split(v, "+")
+		bs := strings.SplitN(v, "+", 2)
 
 		if bs[1] == "" {
 			return Version{}, ErrEmptyBuild
@@ -67,7 +95,7 @@ func Parse(v string) (Version, error) {
 	}
 
 	if strings.Contains(v, "-") {
-		ps := strings.Split(v, "-")
+		ps := strings.SplitN(v, "-", 2)
 
 		if ps[1] == "" {
 			return Version{}, ErrEmptyPrerelease
@@ -78,7 +106,7 @@ func Parse(v string) (Version, error) {
 	}
 
 	for index, version := range strings.Split(v, ".") {
-		if index < 3 {
+		if index < components {
 			iv, err := strconv.Atoi(version)
 
 			if err != nil {
@@ -96,9 +124,80 @@ func Parse(v string) (Version, error) {
 		preRelease: preRelease,
 		build:      build,
 		size:       size,
+		strict:     checkStrict(raw) == nil,
 	}, nil
 }
 
+// ParseStrict parse version string and return version struct. Unlike Parse
+// it requires full SemVer version string (major, minor and patch versions
+// without leading zeros and valid prerelease and build identifiers).
+func ParseStrict(v string) (Version, error) {
+	err := checkStrict(v)
+
+	if err != nil {
+		return Version{}, err
+	}
+
+	return Parse(v)
+}
+
+// Compare compare two versions and return 0 if versions are equal, -1 if
+// a is less than b and 1 if a is greater than b. Build metadata is ignored.
+func Compare(a, b Version) int {
+	for index := 0; index < 4; index++ {
+		switch {
+		case a.component(index) < b.component(index):
+			return -1
+		case a.component(index) > b.component(index):
+			return 1
+		}
+	}
+
+	pr1, pr2 := a.PreRelease(), b.PreRelease()
+
+	switch {
+	case pr1 == pr2:
+		return 0
+	case prereleaseLess(pr1, pr2):
+		return -1
+	}
+
+	return 1
+}
+
+// Sort sort versions slice in ascending order
+func Sort(versions []Version) {
+	sort.Sort(Versions(versions))
+}
+
+// Max return greatest version from given slice (or empty version if slice
+// is empty)
+func Max(versions []Version) Version {
+	var result Version
+
+	for _, v := range versions {
+		if v.raw != "" && (result.raw == "" || Compare(v, result) > 0) {
+			result = v
+		}
+	}
+
+	return result
+}
+
+// Min return least version from given slice (or empty version if slice
+// is empty)
+func Min(versions []Version) Version {
+	var result Version
+
+	for _, v := range versions {
+		if v.raw != "" && (result.raw == "" || Compare(v, result) < 0) {
+			result = v
+		}
+	}
+
+	return result
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
 // Major return major version
@@ -128,6 +227,16 @@ func (v Version) Patch() int {
 	return v.slice[2]
 }
 
+// Revision return revision (fourth version component, always 0 for
+// versions parsed by Parse)
+func (v Version) Revision() int {
+	if v.raw == "" || len(v.slice) == 0 {
+		return -1
+	}
+
+	return v.slice[3]
+}
+
 // PreRelease return prerelease version
 func (v Version) PreRelease() string {
 	if v.raw == "" {
@@ -160,6 +269,10 @@ func (v Version) Equal(version Version) bool {
 		return false
 	}
 
+	if v.Revision() != version.Revision() {
+		return false
+	}
+
 	if v.PreRelease() != version.PreRelease() {
 		return false
 	}
@@ -171,58 +284,32 @@ func (v Version) Equal(version Version) bool {
 	return true
 }
 
-// Less return true if given version is greater
-func (v Version) Less(version Version) bool {
-	if v.Major() > version.Major() {
-		return false
-	}
-
-	if v.Minor() > version.Minor() {
-		return false
-	}
-
-	if v.Patch() > version.Patch() {
-		return false
-	}
-
-	pr1, pr2 := v.PreRelease(), version.PreRelease()
-
-	if pr1 != pr2 {
-		return prereleaseLess(pr1, pr2)
-	}
+// EqualSemantic return true if versions are equal according to SemVer
+// (build metadata is ignored)
+func (v Version) EqualSemantic(version Version) bool {
+	return Compare(v, version) == 0
+}
 
-	if v.slice == version.slice {
-		return false
+// SameRelease return true if versions have same version components
+// (prerelease and build metadata are ignored)
+func (v Version) SameRelease(version Version) bool {
+	for index := 0; index < 4; index++ {
+		if v.component(index) != version.component(index) {
+			return false
+		}
 	}
 
 	return true
 }
 
+// Less return true if given version is greater
+func (v Version) Less(version Version) bool {
+	return Compare(v, version) < 0
+}
+
 // Greater return true if given version is less
 func (v Version) Greater(version Version) bool {
-	if v.Major() < version.Major() {
-		return false
-	}
-
-	if v.Minor() < version.Minor() {
-		return false
-	}
-
-	if v.Patch() < version.Patch() {
-		return false
-	}
-
-	pr1, pr2 := v.PreRelease(), version.PreRelease()
-
-	if pr1 != pr2 {
-		return !prereleaseLess(pr1, pr2)
-	}
-
-	if v.slice == version.slice {
-		return false
-	}
-
-	return true
+	return Compare(v, version) > 0
 }
 
 // Contains check is current version contains given version
@@ -250,14 +337,109 @@ func (v Version) Contains(version Version) bool {
 	return false
 }
 
+// IsZero return true if version struct is empty
+func (v Version) IsZero() bool {
+	return v.raw == ""
+}
+
+// IsStable return true if version is stable release (major version greater
+// than zero and no prerelease)
+func (v Version) IsStable() bool {
+	return v.raw != "" && v.slice[0] > 0 && v.preRelease == ""
+}
+
+// IsPreRelease return true if version is prerelease
+func (v Version) IsPreRelease() bool {
+	return v.PreRelease() != ""
+}
+
+// PreReleaseIdentifiers return slice with dot-separated prerelease identifiers
+func (v Version) PreReleaseIdentifiers() []string {
+	if v.PreRelease() == "" {
+		return nil
+	}
+
+	return strings.Split(v.preRelease, ".")
+}
+
+// IsStrict return true if version string is strict SemVer version
+func (v Version) IsStrict() bool {
+	return v.strict
+}
+
 // String return version as string
 func (v Version) String() string {
 	return v.raw
 }
 
+// Normalized return version in canonical form MAJOR.MINOR.PATCH[-PRE][+BUILD]
+// (revision is added only if it was present in version string)
+func (v Version) Normalized() string {
+	return v.Format(4)
+}
+
+// Format return version string with given number of version components
+// (1-3 or 1-4 for versions with revision) and prerelease and build info
+func (v Version) Format(components int) string {
+	if v.raw == "" {
+		return ""
+	}
+
+	switch {
+	case components < 1:
+		components = 1
+	case components > 4 && v.size == 4:
+		components = 4
+	case components > 3 && v.size != 4:
+		components = 3
+	}
+
+	result := strconv.Itoa(v.slice[0])
+
+	for index := 1; index < components; index++ {
+		result += "." + strconv.Itoa(v.slice[index])
+	}
+
+	if v.preRelease != "" {
+		result += "-" + v.preRelease
+	}
+
+	if v.build != "" {
+		result += "+" + v.build
+	}
+
+	return result
+}
+
 // ////////////////////////////////////////////////////////////////////////////////// //
 
-// prereleaseLess
+// Len return number of versions in slice
+func (s Versions) Len() int {
+	return len(s)
+}
+
+// Less return true if version with index i is less than version with index j
+func (s Versions) Less(i, j int) bool {
+	return Compare(s[i], s[j]) < 0
+}
+
+// Swap swap versions with indexes i and j
+func (s Versions) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// ////////////////////////////////////////////////////////////////////////////////// //
+
+// component return version component with given index
+func (v Version) component(index int) int {
+	if v.raw == "" {
+		return -1
+	}
+
+	return v.slice[index]
+}
+
+// prereleaseLess return true if first prerelease is less than second
 func prereleaseLess(pr1, pr2 string) bool {
 	// Current version is release and given is prerelease
 	if pr1 == "" && pr2 != "" {
@@ -273,6 +455,11 @@ func prereleaseLess(pr1, pr2 string) bool {
 	pr1Re := preRegExp.FindStringSubmatch(pr1)
 	pr2Re := preRegExp.FindStringSubmatch(pr2)
 
+	// Prerelease contains only digits
+	if pr1Re == nil || pr2Re == nil {
+		return pr1 < pr2
+	}
+
 	pr1Name := pr1Re[1]
 	pr2Name := pr2Re[1]
 
@@ -232,3 +232,408 @@ func (s *VersionSuite) TestComparison(c *C) {
 	c.Assert(P("0.10.8").Greater(P("1.0.0")), Equals, false)
 	c.Assert(P("1.0.0").Less(P("0.10.8")), Equals, false)
 }
+
+func (s *VersionSuite) TestCompare(c *C) {
+	var P = func(version string) Version {
+		v, _ := Parse(version)
+		return v
+	}
+
+	c.Assert(Compare(P("1"), P("1.0.0")), Equals, 0)
+	c.Assert(Compare(P("1.0.0+sha:5114f85"), P("1.0.0")), Equals, 0)
+	c.Assert(Compare(P("1.1.5"), P("1.2.0")), Equals, -1)
+	c.Assert(Compare(P("1.2.0"), P("1.1.5")), Equals, 1)
+	c.Assert(Compare(P("2.0.0-alpha"), P("2.0.0")), Equals, -1)
+	c.Assert(Compare(P("2.0.0-beta"), P("2.0.0-alpha")), Equals, 1)
+	c.Assert(Compare(P("2.0.0-1"), P("2.0.0-2")), Equals, -1)
+	c.Assert(Compare(Version{}, P("0.0.1")), Equals, -1)
+
+	c.Assert(P("1.1.5").Less(P("1.2.0")), Equals, true)
+	c.Assert(P("1.2.0").Greater(P("1.1.5")), Equals, true)
+
+	versions := []Version{P("1.2.0"), P("0.10.8"), P("1.2.0-beta1"), P("1.1.5"), P("1.0")}
+
+	Sort(versions)
+
+	c.Assert(versions[0].String(), Equals, "0.10.8")
+	c.Assert(versions[1].String(), Equals, "1.0")
+	c.Assert(versions[2].String(), Equals, "1.1.5")
+	c.Assert(versions[3].String(), Equals, "1.2.0-beta1")
+	c.Assert(versions[4].String(), Equals, "1.2.0")
+}
+
+func (s *VersionSuite) TestConstraint(c *C) {
+	var P = func(version string) Version {
+		v, _ := Parse(version)
+		return v
+	}
+
+	cs, err := NewConstraint(">=1.2.0, <2.0.0 || >=3.0.0")
+
+	c.Assert(err, IsNil)
+	c.Assert(cs.String(), Equals, ">=1.2.0, <2.0.0 || >=3.0.0")
+	c.Assert(cs.Check(P("1.1.9")), Equals, false)
+	c.Assert(cs.Check(P("1.2.0")), Equals, true)
+	c.Assert(cs.Check(P("1.10.3")), Equals, true)
+	c.Assert(cs.Check(P("2.0.0")), Equals, false)
+	c.Assert(cs.Check(P("2.5")), Equals, false)
+	c.Assert(cs.Check(P("3")), Equals, true)
+	c.Assert(cs.Check(Version{}), Equals, false)
+
+	cs, err = NewConstraint("1.2.3")
+
+	c.Assert(err, IsNil)
+	c.Assert(cs.Check(P("1.2.3")), Equals, true)
+	c.Assert(cs.Check(P("1.2.4")), Equals, false)
+
+	cs, err = NewConstraint("> 1.0, <= 1.5, != 1.3.0")
+
+	c.Assert(err, IsNil)
+	c.Assert(cs.Check(P("1.0.0")), Equals, false)
+	c.Assert(cs.Check(P("1.3.0")), Equals, false)
+	c.Assert(cs.Check(P("1.3.1")), Equals, true)
+	c.Assert(cs.Check(P("1.5.0")), Equals, true)
+
+	cs, err = NewConstraint("==2.0.0-beta1")
+
+	c.Assert(err, IsNil)
+	c.Assert(cs.Check(P("2.0.0-beta1")), Equals, true)
+	c.Assert(cs.Check(P("2.0.0")), Equals, false)
+
+	_, err = NewConstraint("")
+	c.Assert(err, Equals, ErrEmptyConstraint)
+
+	_, err = NewConstraint(">=1.0.0, ")
+	c.Assert(err, Equals, ErrEmptyConstraint)
+
+	_, err = NewConstraint(">=1.0.0 || ")
+	c.Assert(err, Equals, ErrEmptyConstraint)
+
+	_, err = NewConstraint(">=A")
+	c.Assert(err, NotNil)
+
+	_, err = NewConstraint("~>1.0")
+	c.Assert(err, NotNil)
+}
+
+func (s *VersionSuite) TestConstraintRanges(c *C) {
+	var P = func(version string) Version {
+		v, _ := Parse(version)
+		return v
+	}
+
+	var C = func(constraint string) Constraint {
+		cs, err := NewConstraint(constraint)
+
+		if err != nil {
+			panic(err.Error())
+		}
+
+		return cs
+	}
+
+	c.Assert(C("~1.2.3").Check(P("1.2.3")), Equals, true)
+	c.Assert(C("~1.2.3").Check(P("1.2.9")), Equals, true)
+	c.Assert(C("~1.2.3").Check(P("1.2.2")), Equals, false)
+	c.Assert(C("~1.2.3").Check(P("1.3.0")), Equals, false)
+	c.Assert(C("~1.2.3").Check(P("1.3.0-alpha")), Equals, false)
+	c.Assert(C("~1.2").Check(P("1.2.0")), Equals, true)
+	c.Assert(C("~1.2").Check(P("1.3.0")), Equals, false)
+	c.Assert(C("~1").Check(P("1.9.0")), Equals, true)
+	c.Assert(C("~1").Check(P("2.0.0")), Equals, false)
+
+	c.Assert(C("^1.2.3").Check(P("1.2.3")), Equals, true)
+	c.Assert(C("^1.2.3").Check(P("1.9.0")), Equals, true)
+	c.Assert(C("^1.2.3").Check(P("1.2.2")), Equals, false)
+	c.Assert(C("^1.2.3").Check(P("2.0.0")), Equals, false)
+	c.Assert(C("^1.2.3").Check(P("2.0.0-beta1")), Equals, false)
+	c.Assert(C("^0.2.3").Check(P("0.2.9")), Equals, true)
+	c.Assert(C("^0.2.3").Check(P("0.3.0")), Equals, false)
+	c.Assert(C("^0.0.3").Check(P("0.0.3")), Equals, true)
+	c.Assert(C("^0.0.3").Check(P("0.0.4")), Equals, false)
+	c.Assert(C("^0").Check(P("0.9.0")), Equals, true)
+	c.Assert(C("^0").Check(P("1.0.0")), Equals, false)
+	c.Assert(C("^0.0").Check(P("0.0.9")), Equals, true)
+	c.Assert(C("^0.0").Check(P("0.1.0")), Equals, false)
+
+	c.Assert(C("1.2.x").Check(P("1.2.0")), Equals, true)
+	c.Assert(C("1.2.*").Check(P("1.2.7")), Equals, true)
+	c.Assert(C("1.2.X").Check(P("1.3.0")), Equals, false)
+	c.Assert(C("1.x").Check(P("1.9.9")), Equals, true)
+	c.Assert(C("1.*.*").Check(P("2.0.0")), Equals, false)
+	c.Assert(C("*").Check(P("0.0.1")), Equals, true)
+	c.Assert(C("x").Check(P("42.0.0")), Equals, true)
+
+	c.Assert(C("~1.2.3 || ^2.1").Check(P("2.5.0")), Equals, true)
+	c.Assert(C("1.x, != 1.4.0").Check(P("1.4.0")), Equals, false)
+
+	var err error
+
+	_, err = NewConstraint(">=1.2.x")
+	c.Assert(err, Equals, ErrWildcardOperator)
+
+	_, err = NewConstraint("1.x.2")
+	c.Assert(err, NotNil)
+
+	_, err = NewConstraint("1.2.3.x")
+	c.Assert(err, NotNil)
+
+	_, err = NewConstraint("1.2.x-beta")
+	c.Assert(err, NotNil)
+
+	_, err = NewConstraint("A.x")
+	c.Assert(err, NotNil)
+
+	_, err = NewConstraint("~")
+	c.Assert(err, NotNil)
+
+	_, err = NewConstraint("^A")
+	c.Assert(err, NotNil)
+}
+
+func (s *VersionSuite) TestStrictParsing(c *C) {
+	v, err := Parse("  v1.2  ")
+
+	c.Assert(err, IsNil)
+	c.Assert(v.Major(), Equals, 1)
+	c.Assert(v.Minor(), Equals, 2)
+	c.Assert(v.Patch(), Equals, 0)
+	c.Assert(v.IsStrict(), Equals, false)
+	c.Assert(v.String(), Equals, "v1.2")
+
+	v, err = Parse("1.0.0-alpha-1+build.01")
+
+	c.Assert(err, IsNil)
+	c.Assert(v.PreRelease(), Equals, "alpha-1")
+	c.Assert(v.Build(), Equals, "build.01")
+	c.Assert(v.IsStrict(), Equals, true)
+
+	v, err = ParseStrict("1.2.3-rc.1+exp.sha.5114f85")
+
+	c.Assert(err, IsNil)
+	c.Assert(v.IsStrict(), Equals, true)
+	c.Assert(v.PreRelease(), Equals, "rc.1")
+
+	_, err = ParseStrict("")
+	c.Assert(err, Equals, ErrEmpty)
+
+	_, err = ParseStrict("1.2")
+	c.Assert(err, Equals, ErrNotFull)
+
+	_, err = ParseStrict("1.2.3.4")
+	c.Assert(err, Equals, ErrNotFull)
+
+	_, err = ParseStrict("v1.2.3")
+	c.Assert(err, NotNil)
+
+	_, err = ParseStrict(" 1.2.3")
+	c.Assert(err, NotNil)
+
+	_, err = ParseStrict("01.2.3")
+	c.Assert(err, Equals, ErrLeadingZero)
+
+	_, err = ParseStrict("1..3")
+	c.Assert(err, Equals, ErrEmptyIdentifier)
+
+	_, err = ParseStrict("1.2.3-alpha..1")
+	c.Assert(err, Equals, ErrEmptyIdentifier)
+
+	_, err = ParseStrict("1.2.3-01")
+	c.Assert(err, Equals, ErrLeadingZero)
+
+	_, err = ParseStrict("1.2.3-beta_1")
+	c.Assert(err, NotNil)
+
+	_, err = ParseStrict("1.2.3+")
+	c.Assert(err, Equals, ErrEmptyIdentifier)
+}
+
+func (s *VersionSuite) TestValidation(c *C) {
+	c.Assert(Validate("1.2.3-rc.1+exp.sha.5114f85"), HasLen, 0)
+	c.Assert(Validate(""), DeepEquals, []error{ErrEmpty})
+
+	c.Assert(Validate("01.2.x-beta..01+b_1"), DeepEquals, []error{
+		&ValidationError{1, ErrLeadingZero},
+		&ValidationError{6, ErrInvalidChar},
+		&ValidationError{13, ErrEmptyIdentifier},
+		&ValidationError{14, ErrLeadingZero},
+		&ValidationError{18, ErrInvalidChar},
+	})
+
+	c.Assert(Validate("1.2"), DeepEquals, []error{&ValidationError{4, ErrNotFull}})
+	c.Assert(Validate("1.2.3.4"), DeepEquals, []error{&ValidationError{7, ErrNotFull}})
+	c.Assert(Validate("v1.2.3"), DeepEquals, []error{&ValidationError{1, ErrInvalidChar}})
+	c.Assert(Validate("1..3"), DeepEquals, []error{&ValidationError{3, ErrEmptyIdentifier}})
+
+	c.Assert(Validate("1.2.B")[0].Error(), Equals, "Invalid character at position 5")
+}
+
+func (s *VersionSuite) TestFormatting(c *C) {
+	var P = func(version string) Version {
+		v, _ := Parse(version)
+		return v
+	}
+
+	c.Assert(P("7").Normalized(), Equals, "7.0.0")
+	c.Assert(P("v2.1").Normalized(), Equals, "2.1.0")
+	c.Assert(P("6.12.1-beta2+exp.sha.5114f85").Normalized(), Equals, "6.12.1-beta2+exp.sha.5114f85")
+	c.Assert(P("5-beta1+sha:5114f85").Normalized(), Equals, "5.0.0-beta1+sha:5114f85")
+	c.Assert(Version{}.Normalized(), Equals, "")
+
+	c.Assert(P("3.4.5").Format(1), Equals, "3")
+	c.Assert(P("3.4.5").Format(2), Equals, "3.4")
+	c.Assert(P("3").Format(3), Equals, "3.0.0")
+	c.Assert(P("3.4.5").Format(0), Equals, "3")
+	c.Assert(P("3.4.5").Format(10), Equals, "3.4.5")
+	c.Assert(P("3.4-rc1").Format(2), Equals, "3.4-rc1")
+}
+
+func (s *VersionSuite) TestClassification(c *C) {
+	var P = func(version string) Version {
+		v, _ := Parse(version)
+		return v
+	}
+
+	c.Assert(Version{}.IsZero(), Equals, true)
+	c.Assert(P("0.0.0").IsZero(), Equals, false)
+	c.Assert(P("1.0.0").IsZero(), Equals, false)
+
+	c.Assert(P("1.0.0").IsStable(), Equals, true)
+	c.Assert(P("1.2.3+exp.sha.5114f85").IsStable(), Equals, true)
+	c.Assert(P("0.9.1").IsStable(), Equals, false)
+	c.Assert(P("1.0.0-rc.1").IsStable(), Equals, false)
+	c.Assert(Version{}.IsStable(), Equals, false)
+
+	c.Assert(P("1.0.0-rc.1").IsPreRelease(), Equals, true)
+	c.Assert(P("1.0.0").IsPreRelease(), Equals, false)
+	c.Assert(Version{}.IsPreRelease(), Equals, false)
+
+	c.Assert(P("1.0.0-rc.1.x-y").PreReleaseIdentifiers(), DeepEquals, []string{"rc", "1", "x-y"})
+	c.Assert(P("1.0.0-beta2").PreReleaseIdentifiers(), DeepEquals, []string{"beta2"})
+	c.Assert(P("1.0.0").PreReleaseIdentifiers(), IsNil)
+}
+
+func (s *VersionSuite) TestCollections(c *C) {
+	var P = func(version string) Version {
+		v, _ := Parse(version)
+		return v
+	}
+
+	versions := []Version{P("1.2.0"), P("0.10.8"), Version{}, P("2.0.0-beta1"), P("1.9.3"), P("3.1.0")}
+
+	c.Assert(Max(versions).String(), Equals, "3.1.0")
+	c.Assert(Min(versions).String(), Equals, "0.10.8")
+	c.Assert(Max(nil).IsZero(), Equals, true)
+	c.Assert(Min(nil).IsZero(), Equals, true)
+	c.Assert(Min([]Version{Version{}}).IsZero(), Equals, true)
+
+	cs, _ := NewConstraint("^1.0.0")
+	c.Assert(cs.Latest(versions).String(), Equals, "1.9.3")
+
+	cs, _ = NewConstraint(">=2.0.0-beta1, <3.0.0")
+	c.Assert(cs.Latest(versions).String(), Equals, "2.0.0-beta1")
+
+	cs, _ = NewConstraint(">=4.0.0")
+	c.Assert(cs.Latest(versions).IsZero(), Equals, true)
+}
+
+func (s *VersionSuite) TestRevision(c *C) {
+	v, err := ParseWithRevision("1.2.3.4-beta1+build5")
+
+	c.Assert(err, IsNil)
+	c.Assert(v.Major(), Equals, 1)
+	c.Assert(v.Minor(), Equals, 2)
+	c.Assert(v.Patch(), Equals, 3)
+	c.Assert(v.Revision(), Equals, 4)
+	c.Assert(v.PreRelease(), Equals, "beta1")
+	c.Assert(v.Normalized(), Equals, "1.2.3.4-beta1+build5")
+	c.Assert(v.Format(3), Equals, "1.2.3-beta1+build5")
+	c.Assert(v.IsStrict(), Equals, false)
+
+	v, _ = Parse("1.2.3.4")
+
+	c.Assert(v.Revision(), Equals, 0)
+	c.Assert(v.Normalized(), Equals, "1.2.3")
+	c.Assert(Version{}.Revision(), Equals, -1)
+
+	v1, _ := ParseWithRevision("10.0.19041.1")
+	v2, _ := ParseWithRevision("10.0.19041.572")
+	v3, _ := ParseWithRevision("10.0.19041")
+
+	c.Assert(Compare(v1, v2), Equals, -1)
+	c.Assert(Compare(v3, v1), Equals, -1)
+	c.Assert(v2.Greater(v1), Equals, true)
+
+	cs, err := NewConstraint(">=10.0.19041.100, <10.1")
+
+	c.Assert(err, IsNil)
+	c.Assert(cs.Check(v1), Equals, false)
+	c.Assert(cs.Check(v2), Equals, true)
+
+	_, err = ParseWithRevision("1.2.3.A")
+	c.Assert(err, NotNil)
+}
+
+func (s *VersionSuite) TestEqualityModes(c *C) {
+	var P = func(version string) Version {
+		v, _ := Parse(version)
+		return v
+	}
+
+	c.Assert(P("1.0.0+build1").Equal(P("1.0.0+build2")), Equals, false)
+	c.Assert(P("1.0.0+build1").EqualSemantic(P("1.0.0+build2")), Equals, true)
+	c.Assert(P("1").EqualSemantic(P("1.0.0")), Equals, true)
+	c.Assert(P("1.0.0-rc1").EqualSemantic(P("1.0.0")), Equals, false)
+	c.Assert(P("1.0.0").EqualSemantic(P("1.0.1")), Equals, false)
+
+	c.Assert(P("1.0.0-rc1+build1").SameRelease(P("1.0.0")), Equals, true)
+	c.Assert(P("1.0").SameRelease(P("1.0.0-beta")), Equals, true)
+	c.Assert(P("1.0.0").SameRelease(P("1.0.1")), Equals, false)
+	c.Assert(Version{}.SameRelease(P("0.0.0")), Equals, false)
+	c.Assert(Version{}.SameRelease(Version{}), Equals, true)
+
+	v1, _ := ParseWithRevision("1.0.0.1")
+	v2, _ := ParseWithRevision("1.0.0.2")
+
+	c.Assert(v1.SameRelease(v2), Equals, false)
+	c.Assert(v1.EqualSemantic(v2), Equals, false)
+	c.Assert(v1.Equal(v2), Equals, false)
+	c.Assert(v1.Equal(v1), Equals, true)
+}
+
+func (s *VersionSuite) TestConstraintSets(c *C) {
+	var C = func(constraint string) Constraint {
+		cs, err := NewConstraint(constraint)
+
+		if err != nil {
+			panic(err.Error())
+		}
+
+		return cs
+	}
+
+	c.Assert(C("^1.2.0").Intersects(C(">=1.5.0")), Equals, true)
+	c.Assert(C("^1.2.0").Intersects(C(">=2.0.0")), Equals, false)
+	c.Assert(C("<=1.0.0").Intersects(C(">=1.0.0")), Equals, true)
+	c.Assert(C("<1.0.0").Intersects(C(">=1.0.0")), Equals, false)
+	c.Assert(C("1.0.0").Intersects(C("!=1.0.0")), Equals, false)
+	c.Assert(C("1.x").Intersects(C("!=1.0.0")), Equals, true)
+	c.Assert(C(">=1.0.0, <1.0.0").Intersects(C("*")), Equals, false)
+	c.Assert(C("~1.2 || ~3.1").Intersects(C(">=3.1.5, <4")), Equals, true)
+
+	c.Assert(C("~1.2.3").Subset(C("^1.0.0")), Equals, true)
+	c.Assert(C("^1.0.0").Subset(C("~1.2.3")), Equals, false)
+	c.Assert(C("1.2.3").Subset(C(">=1.0.0")), Equals, true)
+	c.Assert(C(">=1.0.0").Subset(C("*")), Equals, true)
+	c.Assert(C("*").Subset(C(">=1.0.0")), Equals, false)
+	c.Assert(C("^1.0.0").Subset(C(">=1.0.0, <1.5.0 || >=1.5.0, <2.0.0-0")), Equals, true)
+	c.Assert(C("^1.0.0").Subset(C(">=1.0.0, <1.5.0 || >1.5.0, <2.0.0-0")), Equals, false)
+	c.Assert(C("^1.0.0").Subset(C(">=1.0.0, <1.5.0 || >=1.5.0, <=1.9.0")), Equals, false)
+	c.Assert(C(">1.0.0").Subset(C(">=1.0.0")), Equals, true)
+	c.Assert(C(">=1.0.0").Subset(C(">1.0.0")), Equals, false)
+	c.Assert(C("!=1.0.0").Subset(C("<1.0.0 || >1.0.0")), Equals, true)
+	c.Assert(C("<1.0.0 || >1.0.0").Subset(C("!=1.0.0")), Equals, true)
+	c.Assert(C("*").Subset(C("<1.0.0 || >1.0.0")), Equals, false)
+	c.Assert(C(">=1.0.0, <1.0.0").Subset(C("1.0.0")), Equals, true)
+	c.Assert(C("1.0.0").Subset(C(">=1.0.0, <1.0.0")), Equals, false)
+}